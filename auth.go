@@ -5,5 +5,11 @@ type AuthType string
 
 // AuthType constants
 const (
-	AuthTypeOpenID AuthType = "openID"
+	AuthTypeOpenID       AuthType = "openID"
+	AuthTypeAPIKey       AuthType = "apiKey"
+	AuthTypeBasic        AuthType = "basic"
+	AuthTypeCustomHeader AuthType = "customHeader"
 )
+
+// APIKeyHeader header carrying the API key for AuthTypeAPIKey
+const APIKeyHeader = "X-API-Key"
@@ -1,6 +1,7 @@
 package hydros
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
@@ -13,6 +14,10 @@ const (
 	DefaultURL = "https://localhost"
 	// DefaultAuthType default authentication type
 	DefaultAuthType = AuthTypeOpenID
+	// DefaultWellsByIDsChunkSize default number of IDs sent per GetWellsByIDs request
+	DefaultWellsByIDsChunkSize = 100
+	// DefaultWellsByIDsParallelism default number of GetWellsByIDs requests in flight at once
+	DefaultWellsByIDsParallelism = 4
 )
 
 // NewClient Creates instance of *Client
@@ -35,7 +40,19 @@ func NewClient(options ...ClientOptionFunc) (*Client, error) {
 
 	client.CreateHeadersFunc = func() []RequestHeader {
 		headers := make([]RequestHeader, 2)
-		headers[0] = RequestHeader{Key: "Authorization", Value: fmt.Sprintf("Bearer %s", client.AccessToken)}
+		switch client.AuthType {
+		case AuthTypeAPIKey:
+			headers[0] = RequestHeader{Key: APIKeyHeader, Value: client.APIKey}
+		case AuthTypeBasic:
+			credentials := fmt.Sprintf("%s:%s", client.BasicAuthUsername, client.BasicAuthPassword)
+			headers[0] = RequestHeader{
+				Key:   "Authorization",
+				Value: fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(credentials)))}
+		case AuthTypeCustomHeader:
+			headers[0] = client.AuthHeader
+		default:
+			headers[0] = RequestHeader{Key: "Authorization", Value: fmt.Sprintf("Bearer %s", client.AccessToken)}
+		}
 		headers[1] = RequestHeader{Key: "Content-Type", Value: "application/json"}
 		return headers
 	}
@@ -55,15 +72,38 @@ func NewClient(options ...ClientOptionFunc) (*Client, error) {
 type Client struct {
 	AuthType          AuthType
 	AccessToken       string
+	TokenSource       TokenSource
+	APIKey            string
+	BasicAuthUsername string
+	BasicAuthPassword string
+	AuthHeader        RequestHeader
 	CreateHeadersFunc func() []RequestHeader
 	URL               *url.URL
 	HTTPClient        http.Client
-	Driller           DrillerService
-	History           HistoryService
-	Meter             MeterService
-	MeterReading      MeterReadingService
-	Permit            PermitService
-	Well              WellService
+	RetryPolicy       *RetryPolicy
+	Middleware        []Middleware
+	Logger            Logger
+	Debug             bool
+	SaveMode          SaveMode
+	ConcurrencyMode   ConcurrencyMode
+	// StatusStateMachine allowed well status transitions, DefaultStatusStateMachine when nil
+	StatusStateMachine *StatusStateMachine
+	// StatusIDs IDs of the district's well status records by name, referenced when transitioning a well's status
+	StatusIDs map[string]uint
+	// WellsByIDsChunkSize maximum number of IDs sent per GetWellsByIDs request
+	WellsByIDsChunkSize int
+	// WellsByIDsParallelism maximum number of GetWellsByIDs requests in flight at once
+	WellsByIDsParallelism int
+	// DistrictBoundary polygon wells must be located inside of when created or updated, unchecked when empty
+	DistrictBoundary Polygon
+	Driller          DrillerService
+	History          HistoryService
+	Meter            MeterService
+	MeterReading     MeterReadingService
+	Permit           PermitService
+	Well             WellService
+
+	throttle *throttle
 }
 
 // RequestHeader hold key value pairs
@@ -98,3 +138,64 @@ func SetAccessToken(accessToken string) ClientOptionFunc {
 		return nil
 	}
 }
+
+// SetAPIKey authenticates requests with a district-issued API key sent in the X-API-Key header
+func SetAPIKey(apiKey string) ClientOptionFunc {
+	return func(c *Client) error {
+		if apiKey == "" {
+			return errors.New("API key must not be empty")
+		}
+		c.AuthType = AuthTypeAPIKey
+		c.APIKey = apiKey
+		return nil
+	}
+}
+
+// SetBasicAuth authenticates requests using HTTP basic authentication
+func SetBasicAuth(username string, password string) ClientOptionFunc {
+	return func(c *Client) error {
+		if username == "" {
+			return errors.New("basic auth username must not be empty")
+		}
+		c.AuthType = AuthTypeBasic
+		c.BasicAuthUsername = username
+		c.BasicAuthPassword = password
+		return nil
+	}
+}
+
+// SetAuthHeader authenticates requests by sending a custom header (e.g. "X-District-Token")
+func SetAuthHeader(key string, value string) ClientOptionFunc {
+	return func(c *Client) error {
+		if key == "" {
+			return errors.New("auth header key must not be empty")
+		}
+		c.AuthType = AuthTypeCustomHeader
+		c.AuthHeader = RequestHeader{Key: key, Value: value}
+		return nil
+	}
+}
+
+// SetWellsByIDsBatching sets how many IDs GetWellsByIDs sends per request and how many requests it runs in parallel
+func SetWellsByIDsBatching(chunkSize int, parallelism int) ClientOptionFunc {
+	return func(c *Client) error {
+		if chunkSize < 1 || parallelism < 1 {
+			return errors.New("wells by IDs chunk size and parallelism must be at least 1")
+		}
+		c.WellsByIDsChunkSize = chunkSize
+		c.WellsByIDsParallelism = parallelism
+		return nil
+	}
+}
+
+// wellsByIDsBatching returns the configured GetWellsByIDs batching, falling back to the defaults
+func (client *Client) wellsByIDsBatching() (int, int) {
+	chunkSize, parallelism := client.WellsByIDsChunkSize, client.WellsByIDsParallelism
+	if chunkSize < 1 {
+		chunkSize = DefaultWellsByIDsChunkSize
+	}
+	if parallelism < 1 {
+		parallelism = DefaultWellsByIDsParallelism
+	}
+	return chunkSize, parallelism
+}
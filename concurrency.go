@@ -0,0 +1,89 @@
+package hydros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ConcurrencyMode how well updates detect changes made by others since the well was fetched
+type ConcurrencyMode string
+
+// ConcurrencyMode constants
+const (
+	// ConcurrencyNone sends no version information, so the last write wins
+	ConcurrencyNone ConcurrencyMode = "none"
+	// ConcurrencyETag sends the ETag the well was fetched with in an If-Match header, falling back to UpdatedAt for
+	// wells fetched without one (e.g. from search results)
+	ConcurrencyETag ConcurrencyMode = "etag"
+	// ConcurrencyUpdatedAt sends the well's UpdatedAt in an If-Unmodified-Since header
+	ConcurrencyUpdatedAt ConcurrencyMode = "updatedAt"
+)
+
+// SetConcurrencyMode enables conflict detection for well updates.  Conflicting updates fail with a *WellConflictError
+func SetConcurrencyMode(mode ConcurrencyMode) ClientOptionFunc {
+	return func(c *Client) error {
+		if mode != ConcurrencyNone && mode != ConcurrencyETag && mode != ConcurrencyUpdatedAt {
+			return fmt.Errorf("unknown concurrency mode '%s'", mode)
+		}
+		c.ConcurrencyMode = mode
+		return nil
+	}
+}
+
+// WellConflictError returned when a well was modified by someone else since it was fetched.  Current holds the
+// server's version of the well so changes can be merged and retried
+type WellConflictError struct {
+	// Current well as stored on the server, nil if it could not be fetched
+	Current *WellModel
+	// Err response rejecting the update
+	Err *APIError
+}
+
+// Error describes the conflict
+func (e *WellConflictError) Error() string {
+	return fmt.Sprintf("well was modified since it was fetched: %s", e.Err.Error())
+}
+
+// Unwrap returns the API error rejecting the update
+func (e *WellConflictError) Unwrap() error {
+	return e.Err
+}
+
+// versionHeaders precondition headers for updating model under the client's concurrency mode
+func (client *Client) versionHeaders(model *WellModel) []RequestHeader {
+	switch client.ConcurrencyMode {
+	case ConcurrencyETag:
+		if model.etag != "" {
+			return []RequestHeader{{Key: "If-Match", Value: model.etag}}
+		}
+		fallthrough
+	case ConcurrencyUpdatedAt:
+		if !model.UpdatedAt.IsZero() {
+			return []RequestHeader{{Key: "If-Unmodified-Since", Value: model.UpdatedAt.UTC().Format(http.TimeFormat)}}
+		}
+	}
+	return nil
+}
+
+// wellConflict converts a failed precondition into a *WellConflictError carrying the server's current well.  Other
+// errors are returned unchanged
+func (client *Client) wellConflict(ctx context.Context, model *WellModel, err error) error {
+	var apiError *APIError
+	if client.ConcurrencyMode == "" || client.ConcurrencyMode == ConcurrencyNone || !errors.As(err, &apiError) ||
+		(apiError.StatusCode != http.StatusPreconditionFailed && apiError.StatusCode != http.StatusConflict) {
+		return err
+	}
+	conflict := &WellConflictError{Err: apiError}
+	if current, getErr := client.Well.GetContext(ctx, model.ID); getErr == nil {
+		conflict.Current = current
+	}
+	return conflict
+}
+
+// IsWellConflict reports whether err is a *WellConflictError
+func IsWellConflict(err error) bool {
+	var conflict *WellConflictError
+	return errors.As(err, &conflict)
+}
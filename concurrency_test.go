@@ -0,0 +1,78 @@
+package hydros
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSetConcurrencyMode(t *testing.T) {
+
+	_, err := NewClient(SetConcurrencyMode("pessimistic"))
+	assert.EqualError(t, err, "unknown concurrency mode 'pessimistic'")
+
+	client, err := NewClient(SetConcurrencyMode(ConcurrencyUpdatedAt))
+	assert.Nil(t, err, "Error should be nil.")
+	updatedAt := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+	well := &WellModel{DefaultModelBase: &DefaultModelBase{ID: 7}, UpdatedAt: updatedAt, etag: `"v1"`}
+	assert.Equal(t, []RequestHeader{{Key: "If-Unmodified-Since", Value: "Wed, 04 Mar 2020 05:06:07 GMT"}},
+		client.versionHeaders(well))
+
+	client.ConcurrencyMode = ConcurrencyETag
+	assert.Equal(t, []RequestHeader{{Key: "If-Match", Value: `"v1"`}}, client.versionHeaders(well))
+	well.etag = ""
+	assert.Equal(t, "If-Unmodified-Since", client.versionHeaders(well)[0].Key)
+
+	client.ConcurrencyMode = ConcurrencyNone
+	assert.Nil(t, client.versionHeaders(well))
+}
+
+func TestWellModel_UpdateConflict(t *testing.T) {
+
+	version := `"v1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", version)
+			_, _ = w.Write([]byte(`{"id":7,"serial":"W-7","name":"` + version[1:3] + `"}`))
+		case http.MethodPatch:
+			if r.Header.Get("If-Match") != version {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_, _ = w.Write([]byte(`{"message":"Precondition Failed","description":"well has changed"}`))
+				return
+			}
+			version = `"v2"`
+			w.Header().Set("ETag", version)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"id":7,"serial":"W-7","name":"v2"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL), SetConcurrencyMode(ConcurrencyETag))
+	assert.Nil(t, err, "Error should be nil.")
+
+	first, err := client.Well.Get(7)
+	assert.Nil(t, err, "Error should be nil.")
+	second, err := client.Well.Get(7)
+	assert.Nil(t, err, "Error should be nil.")
+
+	updated, err := first.Update([]byte(`{"name":"v2"}`))
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, `"v2"`, updated.etag)
+
+	_, err = second.Update([]byte(`{"name":"stale"}`))
+	assert.True(t, IsWellConflict(err))
+	var conflict *WellConflictError
+	if assert.True(t, errors.As(err, &conflict)) {
+		assert.Equal(t, http.StatusPreconditionFailed, conflict.Err.StatusCode)
+		if assert.NotNil(t, conflict.Current) {
+			assert.Equal(t, "v2", conflict.Current.Name.String)
+			_, err = conflict.Current.Update([]byte(`{"name":"merged"}`))
+			assert.Nil(t, err, "Error should be nil.")
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package hydros
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"gopkg.in/guregu/null.v3"
+)
+
+// USSurveyFoot US survey foot in meters, the unit of NAD27 State Plane coordinates
+const USSurveyFoot = 1200.0 / 3937.0
+
+// utmScaleFactor UTM central meridian scale factor
+const utmScaleFactor = 0.9996
+
+// Ellipsoid reference ellipsoid
+type Ellipsoid struct {
+	SemiMajorAxis     float64
+	InverseFlattening float64
+}
+
+// Reference ellipsoids
+var (
+	EllipsoidWGS84      = Ellipsoid{SemiMajorAxis: 6378137, InverseFlattening: 298.257223563}
+	EllipsoidGRS80      = Ellipsoid{SemiMajorAxis: 6378137, InverseFlattening: 298.257222101}
+	EllipsoidClarke1866 = Ellipsoid{SemiMajorAxis: 6378206.4, InverseFlattening: 294.978698214}
+)
+
+// flattening f
+func (ellipsoid Ellipsoid) flattening() float64 {
+	return 1 / ellipsoid.InverseFlattening
+}
+
+// eccentricitySquared e²
+func (ellipsoid Ellipsoid) eccentricitySquared() float64 {
+	f := ellipsoid.flattening()
+	return f * (2 - f)
+}
+
+// Datum geodetic datum coordinates are referenced to
+type Datum string
+
+// Datum constants.  NAD83 and WGS84 are treated as identical, which is accurate to about 2 m
+const (
+	DatumWGS84 Datum = "WGS84"
+	DatumNAD83 Datum = "NAD83"
+	DatumNAD27 Datum = "NAD27"
+)
+
+// Ellipsoid reference ellipsoid of the datum
+func (datum Datum) Ellipsoid() Ellipsoid {
+	switch datum {
+	case DatumNAD27:
+		return EllipsoidClarke1866
+	case DatumNAD83:
+		return EllipsoidGRS80
+	}
+	return EllipsoidWGS84
+}
+
+// nad27ToWGS84Shift mean NAD27 to WGS84 geocentric shift in meters for the continental United States
+var nad27ToWGS84Shift = [3]float64{-8, 160, 176}
+
+// ConvertDatum shifts point from one datum to another using the Molodensky transformation.  NAD27 conversions use
+// the continental US mean shift and are accurate to about 5 m, so use NADCON grids where survey accuracy matters
+func ConvertDatum(point Point, from Datum, to Datum) Point {
+	if from == DatumNAD83 {
+		from = DatumWGS84
+	}
+	if to == DatumNAD83 {
+		to = DatumWGS84
+	}
+	switch {
+	case from == to:
+		return point
+	case from == DatumNAD27 && to == DatumWGS84:
+		return molodensky(point, EllipsoidClarke1866, EllipsoidWGS84, nad27ToWGS84Shift)
+	case from == DatumWGS84 && to == DatumNAD27:
+		shift := [3]float64{-nad27ToWGS84Shift[0], -nad27ToWGS84Shift[1], -nad27ToWGS84Shift[2]}
+		return molodensky(point, EllipsoidWGS84, EllipsoidClarke1866, shift)
+	}
+	return point
+}
+
+// molodensky applies the standard Molodensky transformation at zero height
+func molodensky(point Point, from Ellipsoid, to Ellipsoid, shift [3]float64) Point {
+	lat, lon := toRadians(point.Latitude), toRadians(point.Longitude)
+	sinLat, cosLat := math.Sin(lat), math.Cos(lat)
+	sinLon, cosLon := math.Sin(lon), math.Cos(lon)
+
+	a, f, e2 := from.SemiMajorAxis, from.flattening(), from.eccentricitySquared()
+	b := a * (1 - f)
+	da := to.SemiMajorAxis - a
+	df := to.flattening() - f
+
+	denominator := 1 - e2*sinLat*sinLat
+	meridianRadius := a * (1 - e2) / math.Pow(denominator, 1.5)
+	primeVerticalRadius := a / math.Sqrt(denominator)
+
+	dLat := (-shift[0]*sinLat*cosLon - shift[1]*sinLat*sinLon + shift[2]*cosLat +
+		da*primeVerticalRadius*e2*sinLat*cosLat/a +
+		df*(meridianRadius*a/b+primeVerticalRadius*b/a)*sinLat*cosLat) / meridianRadius
+	dLon := (-shift[0]*sinLon + shift[1]*cosLon) / (primeVerticalRadius * cosLat)
+
+	return Point{Latitude: point.Latitude + toDegrees(dLat), Longitude: point.Longitude + toDegrees(dLon)}
+}
+
+// UTMCoordinate Universal Transverse Mercator coordinate in meters.  Texas lies in zones 13 to 15
+type UTMCoordinate struct {
+	Zone     int
+	Southern bool
+	Easting  float64
+	Northing float64
+}
+
+// UTMZone zone containing longitude
+func UTMZone(longitude float64) int {
+	zone := int(math.Floor((longitude+180)/6)) + 1
+	if zone > 60 {
+		zone = 60
+	}
+	return zone
+}
+
+// ToUTM projects point onto zone using ellipsoid, e.g. the ellipsoid of the point's datum.  Zone 0 selects the zone
+// containing the point
+func ToUTM(point Point, zone int, ellipsoid Ellipsoid) (UTMCoordinate, error) {
+	if zone == 0 {
+		zone = UTMZone(point.Longitude)
+	}
+	if zone < 1 || zone > 60 {
+		return UTMCoordinate{}, fmt.Errorf("UTM zone %d is out of range", zone)
+	}
+	if point.Latitude < -80 || point.Latitude > 84 {
+		return UTMCoordinate{}, errors.New("UTM is only defined between 80S and 84N")
+	}
+
+	a, e2 := ellipsoid.SemiMajorAxis, ellipsoid.eccentricitySquared()
+	ep2 := e2 / (1 - e2)
+	lat := toRadians(point.Latitude)
+	lon := toRadians(point.Longitude)
+	centralMeridian := toRadians(float64(zone*6 - 183))
+
+	sinLat, cosLat, tanLat := math.Sin(lat), math.Cos(lat), math.Tan(lat)
+	n := a / math.Sqrt(1-e2*sinLat*sinLat)
+	t := tanLat * tanLat
+	c := ep2 * cosLat * cosLat
+	A := cosLat * (lon - centralMeridian)
+	m := meridianArc(lat, a, e2)
+
+	easting := utmScaleFactor*n*(A+(1-t+c)*math.Pow(A, 3)/6+
+		(5-18*t+t*t+72*c-58*ep2)*math.Pow(A, 5)/120) + 500000
+	northing := utmScaleFactor * (m + n*tanLat*(A*A/2+(5-t+9*c+4*c*c)*math.Pow(A, 4)/24+
+		(61-58*t+t*t+600*c-330*ep2)*math.Pow(A, 6)/720))
+
+	coordinate := UTMCoordinate{Zone: zone, Easting: easting, Northing: northing}
+	if point.Latitude < 0 {
+		coordinate.Southern = true
+		coordinate.Northing += 10000000
+	}
+	return coordinate, nil
+}
+
+// ToPoint converts the coordinate back to latitude and longitude on ellipsoid
+func (coordinate UTMCoordinate) ToPoint(ellipsoid Ellipsoid) (Point, error) {
+	if coordinate.Zone < 1 || coordinate.Zone > 60 {
+		return Point{}, fmt.Errorf("UTM zone %d is out of range", coordinate.Zone)
+	}
+
+	a, e2 := ellipsoid.SemiMajorAxis, ellipsoid.eccentricitySquared()
+	ep2 := e2 / (1 - e2)
+	x := coordinate.Easting - 500000
+	y := coordinate.Northing
+	if coordinate.Southern {
+		y -= 10000000
+	}
+	centralMeridian := toRadians(float64(coordinate.Zone*6 - 183))
+
+	mu := y / utmScaleFactor / (a * (1 - e2/4 - 3*e2*e2/64 - 5*e2*e2*e2/256))
+	e1 := (1 - math.Sqrt(1-e2)) / (1 + math.Sqrt(1-e2))
+	footLat := mu + (3*e1/2-27*math.Pow(e1, 3)/32)*math.Sin(2*mu) +
+		(21*e1*e1/16-55*math.Pow(e1, 4)/32)*math.Sin(4*mu) +
+		(151*math.Pow(e1, 3)/96)*math.Sin(6*mu) +
+		(1097*math.Pow(e1, 4)/512)*math.Sin(8*mu)
+
+	sinLat, cosLat, tanLat := math.Sin(footLat), math.Cos(footLat), math.Tan(footLat)
+	c1 := ep2 * cosLat * cosLat
+	t1 := tanLat * tanLat
+	n1 := a / math.Sqrt(1-e2*sinLat*sinLat)
+	r1 := a * (1 - e2) / math.Pow(1-e2*sinLat*sinLat, 1.5)
+	d := x / (n1 * utmScaleFactor)
+
+	lat := footLat - (n1*tanLat/r1)*(d*d/2-(5+3*t1+10*c1-4*c1*c1-9*ep2)*math.Pow(d, 4)/24+
+		(61+90*t1+298*c1+45*t1*t1-252*ep2-3*c1*c1)*math.Pow(d, 6)/720)
+	lon := centralMeridian + (d-(1+2*t1+c1)*math.Pow(d, 3)/6+
+		(5-2*c1+28*t1-3*c1*c1+8*ep2+24*t1*t1)*math.Pow(d, 5)/120)/cosLat
+
+	return Point{Latitude: toDegrees(lat), Longitude: toDegrees(lon)}, nil
+}
+
+// meridianArc distance along the meridian from the equator to lat
+func meridianArc(lat float64, a float64, e2 float64) float64 {
+	e4, e6 := e2*e2, e2*e2*e2
+	return a * ((1-e2/4-3*e4/64-5*e6/256)*lat -
+		(3*e2/8+3*e4/32+45*e6/1024)*math.Sin(2*lat) +
+		(15*e4/256+45*e6/1024)*math.Sin(4*lat) -
+		(35*e6/3072)*math.Sin(6*lat))
+}
+
+// StatePlaneZone Lambert Conformal Conic State Plane zone.  Angles are in decimal degrees and false origins in meters
+type StatePlaneZone struct {
+	Name            string
+	Datum           Datum
+	StandardLat1    float64
+	StandardLat2    float64
+	OriginLatitude  float64
+	CentralMeridian float64
+	FalseEasting    float64
+	FalseNorthing   float64
+}
+
+// dms converts degrees and minutes to decimal degrees
+func dms(degrees float64, minutes float64) float64 {
+	if degrees < 0 {
+		return degrees - minutes/60
+	}
+	return degrees + minutes/60
+}
+
+// Texas State Plane zones
+var (
+	TexasNorthNAD83        = StatePlaneZone{"Texas North", DatumNAD83, dms(36, 11), dms(34, 39), 34, dms(-101, 30), 200000, 1000000}
+	TexasNorthCentralNAD83 = StatePlaneZone{"Texas North Central", DatumNAD83, dms(33, 58), dms(32, 8), dms(31, 40), dms(-98, 30), 600000, 2000000}
+	TexasCentralNAD83      = StatePlaneZone{"Texas Central", DatumNAD83, dms(31, 53), dms(30, 7), dms(29, 40), dms(-100, 20), 700000, 3000000}
+	TexasSouthCentralNAD83 = StatePlaneZone{"Texas South Central", DatumNAD83, dms(30, 17), dms(28, 23), dms(27, 50), -99, 600000, 4000000}
+	TexasSouthNAD83        = StatePlaneZone{"Texas South", DatumNAD83, dms(27, 50), dms(26, 10), dms(25, 40), dms(-98, 30), 300000, 5000000}
+
+	TexasNorthNAD27        = StatePlaneZone{"Texas North", DatumNAD27, dms(36, 11), dms(34, 39), 34, dms(-101, 30), 2000000 * USSurveyFoot, 0}
+	TexasNorthCentralNAD27 = StatePlaneZone{"Texas North Central", DatumNAD27, dms(33, 58), dms(32, 8), dms(31, 40), dms(-97, 30), 2000000 * USSurveyFoot, 0}
+	TexasCentralNAD27      = StatePlaneZone{"Texas Central", DatumNAD27, dms(31, 53), dms(30, 7), dms(29, 40), dms(-100, 20), 2000000 * USSurveyFoot, 0}
+	TexasSouthCentralNAD27 = StatePlaneZone{"Texas South Central", DatumNAD27, dms(30, 17), dms(28, 23), dms(27, 50), -99, 2000000 * USSurveyFoot, 0}
+	TexasSouthNAD27        = StatePlaneZone{"Texas South", DatumNAD27, dms(27, 50), dms(26, 10), dms(25, 40), dms(-98, 30), 2000000 * USSurveyFoot, 0}
+)
+
+// StatePlaneCoordinate State Plane coordinate in meters.  Divide by USSurveyFoot for US survey feet
+type StatePlaneCoordinate struct {
+	Zone     StatePlaneZone
+	Easting  float64
+	Northing float64
+}
+
+// lccConstants cone constant n, mapping radius factor aF and origin radius rho0 of the zone
+func (zone StatePlaneZone) lccConstants() (n float64, aF float64, rho0 float64) {
+	ellipsoid := zone.Datum.Ellipsoid()
+	e := math.Sqrt(ellipsoid.eccentricitySquared())
+	lat1, lat2 := toRadians(zone.StandardLat1), toRadians(zone.StandardLat2)
+	m1, m2 := lccM(lat1, e), lccM(lat2, e)
+	t1, t2 := lccT(lat1, e), lccT(lat2, e)
+	n = (math.Log(m1) - math.Log(m2)) / (math.Log(t1) - math.Log(t2))
+	aF = ellipsoid.SemiMajorAxis * m1 / (n * math.Pow(t1, n))
+	rho0 = aF * math.Pow(lccT(toRadians(zone.OriginLatitude), e), n)
+	return n, aF, rho0
+}
+
+// ToStatePlane projects point, referenced to the zone's datum, onto the zone
+func ToStatePlane(point Point, zone StatePlaneZone) StatePlaneCoordinate {
+	e := math.Sqrt(zone.Datum.Ellipsoid().eccentricitySquared())
+	n, aF, rho0 := zone.lccConstants()
+	rho := aF * math.Pow(lccT(toRadians(point.Latitude), e), n)
+	theta := n * toRadians(point.Longitude-zone.CentralMeridian)
+	return StatePlaneCoordinate{
+		Zone:     zone,
+		Easting:  zone.FalseEasting + rho*math.Sin(theta),
+		Northing: zone.FalseNorthing + rho0 - rho*math.Cos(theta),
+	}
+}
+
+// ToPoint converts the coordinate back to latitude and longitude referenced to the zone's datum
+func (coordinate StatePlaneCoordinate) ToPoint() Point {
+	zone := coordinate.Zone
+	e := math.Sqrt(zone.Datum.Ellipsoid().eccentricitySquared())
+	n, aF, rho0 := zone.lccConstants()
+
+	x := coordinate.Easting - zone.FalseEasting
+	y := rho0 - (coordinate.Northing - zone.FalseNorthing)
+	rho := math.Copysign(math.Sqrt(x*x+y*y), n)
+	theta := math.Atan2(x, y)
+	t := math.Pow(rho/aF, 1/n)
+
+	lat := math.Pi/2 - 2*math.Atan(t)
+	for i := 0; i < 15; i++ {
+		next := math.Pi/2 - 2*math.Atan(t*math.Pow((1-e*math.Sin(lat))/(1+e*math.Sin(lat)), e/2))
+		if math.Abs(next-lat) < 1e-12 {
+			lat = next
+			break
+		}
+		lat = next
+	}
+	return Point{Latitude: toDegrees(lat), Longitude: zone.CentralMeridian + toDegrees(theta/n)}
+}
+
+// lccM Lambert Conformal Conic m function
+func lccM(lat float64, e float64) float64 {
+	return math.Cos(lat) / math.Sqrt(1-e*e*math.Sin(lat)*math.Sin(lat))
+}
+
+// lccT Lambert Conformal Conic t function
+func lccT(lat float64, e float64) float64 {
+	sinLat := math.Sin(lat)
+	return math.Tan(math.Pi/4-lat/2) / math.Pow((1-e*sinLat)/(1+e*sinLat), e/2)
+}
+
+// toDegrees converts radians to degrees
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
+
+// OutsideDistrictError returned when a well is created or updated with a location outside the district boundary
+type OutsideDistrictError struct {
+	Point Point
+}
+
+// Error formats the offending point
+func (e *OutsideDistrictError) Error() string {
+	return fmt.Sprintf("location %f, %f is outside the district boundary", e.Point.Latitude, e.Point.Longitude)
+}
+
+// SetDistrictBoundary rejects creating or updating wells located outside boundary, a WGS84 polygon, before any
+// request is sent.  Wells without coordinates are not checked
+func SetDistrictBoundary(boundary Polygon) ClientOptionFunc {
+	return func(c *Client) error {
+		if len(boundary) < 3 {
+			return errors.New("district boundary must have at least 3 points")
+		}
+		c.DistrictBoundary = boundary
+		return nil
+	}
+}
+
+// ValidateLocation checks location has valid coordinates inside boundary.  Locations without coordinates and empty
+// boundaries pass
+func ValidateLocation(location *LocationModel, boundary Polygon) error {
+	if location == nil || !location.Latitude.Valid || !location.Longitude.Valid {
+		return nil
+	}
+	return validatePoint(Point{Latitude: location.Latitude.Float64, Longitude: location.Longitude.Float64}, boundary)
+}
+
+// validatePoint checks point is a valid coordinate inside boundary
+func validatePoint(point Point, boundary Polygon) error {
+	if point.Latitude < -90 || point.Latitude > 90 || point.Longitude < -180 || point.Longitude > 180 {
+		return fmt.Errorf("location %f, %f is not a valid latitude and longitude", point.Latitude, point.Longitude)
+	}
+	if len(boundary) > 0 && !boundary.Contains(point) {
+		return &OutsideDistrictError{Point: point}
+	}
+	return nil
+}
+
+// validatePatchLocation checks the location a JSON merge patch would give model against the district boundary
+func (client *Client) validatePatchLocation(model *WellModel, JSONMergePatch []byte) error {
+	if len(client.DistrictBoundary) == 0 {
+		return nil
+	}
+	var patch struct {
+		Location *struct {
+			Latitude  *null.Float `json:"latitude"`
+			Longitude *null.Float `json:"longitude"`
+		} `json:"location"`
+	}
+	if err := json.Unmarshal(JSONMergePatch, &patch); err != nil || patch.Location == nil {
+		return nil
+	}
+
+	location := &LocationModel{}
+	if model.Location != nil {
+		location.Latitude, location.Longitude = model.Location.Latitude, model.Location.Longitude
+	}
+	if patch.Location.Latitude != nil {
+		location.Latitude = *patch.Location.Latitude
+	}
+	if patch.Location.Longitude != nil {
+		location.Longitude = *patch.Location.Longitude
+	}
+	return ValidateLocation(location, client.DistrictBoundary)
+}
@@ -0,0 +1,121 @@
+package hydros
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestToUTM(t *testing.T) {
+
+	// Snyder, Map Projections: A Working Manual, p. 269
+	point := Point{Latitude: 40.5, Longitude: -73.5}
+	coordinate, err := ToUTM(point, 18, EllipsoidClarke1866)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, 18, coordinate.Zone)
+	assert.InDelta(t, 627106.5, coordinate.Easting, 0.5)
+	assert.InDelta(t, 4484124.4, coordinate.Northing, 0.5)
+
+	austin := Point{Latitude: 30.2672, Longitude: -97.7431}
+	coordinate, err = ToUTM(austin, 0, EllipsoidWGS84)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, 14, coordinate.Zone)
+	converted, err := coordinate.ToPoint(EllipsoidWGS84)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.InDelta(t, austin.Latitude, converted.Latitude, 1e-8)
+	assert.InDelta(t, austin.Longitude, converted.Longitude, 1e-8)
+
+	_, err = ToUTM(austin, 61, EllipsoidWGS84)
+	assert.NotNil(t, err, "Error should not be nil.")
+	assert.Equal(t, 13, UTMZone(-104.5))
+	assert.Equal(t, 15, UTMZone(-93.9))
+}
+
+func TestToStatePlane(t *testing.T) {
+
+	// Snyder, Map Projections: A Working Manual, p. 296
+	zone := StatePlaneZone{Datum: DatumNAD27, StandardLat1: 33, StandardLat2: 45, OriginLatitude: 23, CentralMeridian: -96}
+	coordinate := ToStatePlane(Point{Latitude: 35, Longitude: -75}, zone)
+	assert.InDelta(t, 1894410.9, coordinate.Easting, 0.5)
+	assert.InDelta(t, 1564649.5, coordinate.Northing, 0.5)
+
+	for _, zone := range []StatePlaneZone{TexasCentralNAD83, TexasCentralNAD27} {
+		austin := Point{Latitude: 30.2672, Longitude: -97.7431}
+		converted := ToStatePlane(austin, zone).ToPoint()
+		assert.InDelta(t, austin.Latitude, converted.Latitude, 1e-9)
+		assert.InDelta(t, austin.Longitude, converted.Longitude, 1e-9)
+	}
+
+	origin := ToStatePlane(Point{Latitude: TexasNorthNAD83.OriginLatitude, Longitude: TexasNorthNAD83.CentralMeridian}, TexasNorthNAD83)
+	assert.InDelta(t, 200000, origin.Easting, 1e-6)
+	assert.InDelta(t, 1000000, origin.Northing, 1e-6)
+	origin = ToStatePlane(Point{Latitude: TexasSouthNAD27.OriginLatitude, Longitude: TexasSouthNAD27.CentralMeridian}, TexasSouthNAD27)
+	assert.InDelta(t, 2000000, origin.Easting/USSurveyFoot, 1e-6)
+}
+
+func TestConvertDatum(t *testing.T) {
+
+	nad27 := Point{Latitude: 30.2672, Longitude: -97.7431}
+	nad83 := ConvertDatum(nad27, DatumNAD27, DatumNAD83)
+	shift := Haversine(nad27, nad83).Meters()
+	assert.True(t, shift > 10 && shift < 60, "Texas NAD27 shift should be tens of meters, got %f", shift)
+	assert.True(t, nad83.Latitude > nad27.Latitude, "Latitude should shift north")
+
+	roundTrip := ConvertDatum(nad83, DatumNAD83, DatumNAD27)
+	assert.InDelta(t, 0, Haversine(nad27, roundTrip).Meters(), 0.01)
+	assert.Equal(t, nad27, ConvertDatum(nad27, DatumNAD83, DatumWGS84))
+}
+
+func TestValidateLocation(t *testing.T) {
+
+	boundary := Polygon{{Latitude: 36, Longitude: -101}, {Latitude: 36, Longitude: -100}, {Latitude: 37, Longitude: -100}, {Latitude: 37, Longitude: -101}}
+	inside := &LocationModel{Latitude: null.FloatFrom(36.5), Longitude: null.FloatFrom(-100.5)}
+	outside := &LocationModel{Latitude: null.FloatFrom(35.5), Longitude: null.FloatFrom(-100.5)}
+
+	assert.Nil(t, ValidateLocation(inside, boundary), "Error should be nil.")
+	assert.Nil(t, ValidateLocation(&LocationModel{}, boundary), "Error should be nil.")
+	assert.Nil(t, ValidateLocation(outside, nil), "Error should be nil.")
+
+	var outsideError *OutsideDistrictError
+	assert.True(t, errors.As(ValidateLocation(outside, boundary), &outsideError))
+	assert.NotNil(t, ValidateLocation(&LocationModel{Latitude: null.FloatFrom(95), Longitude: null.FloatFrom(-100)}, nil))
+
+	_, err := NewClient(SetDistrictBoundary(boundary[:2]))
+	assert.NotNil(t, err, "Error should not be nil.")
+}
+
+func TestSetDistrictBoundary(t *testing.T) {
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":1,"location":{"latitude":36.5,"longitude":-100.5}}`))
+	}))
+	defer server.Close()
+
+	boundary := Polygon{{Latitude: 36, Longitude: -101}, {Latitude: 36, Longitude: -100}, {Latitude: 37, Longitude: -100}, {Latitude: 37, Longitude: -101}}
+	client, err := NewClient(SetHost(server.URL), SetDistrictBoundary(boundary))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Well.Create(&WellModel{Location: &LocationModel{Latitude: null.FloatFrom(35.5), Longitude: null.FloatFrom(-100.5)}})
+	var outsideError *OutsideDistrictError
+	assert.True(t, errors.As(err, &outsideError))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests))
+
+	well, err := client.Well.Create(&WellModel{Location: &LocationModel{Latitude: null.FloatFrom(36.5), Longitude: null.FloatFrom(-100.5)}})
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	_, err = well.UpdateContext(context.Background(), []byte(`{"location":{"latitude":38}}`))
+	assert.True(t, errors.As(err, &outsideError))
+	well.Location.Longitude = null.FloatFrom(-99)
+	_, err = well.Save()
+	assert.True(t, errors.As(err, &outsideError))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
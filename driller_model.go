@@ -1,7 +1,7 @@
 package hydros
 
 import (
-	"errors"
+	"context"
 	"gopkg.in/guregu/null.v3"
 )
 
@@ -22,8 +22,10 @@ type DrillerModel struct {
 	PostalCode             null.String         `json:"postalCode"`
 	PhoneNumbers           []*PhoneNumberModel `json:"phoneNumbers"`
 
-	_Save   func(model *DrillerModel) (*DrillerModel, error)
-	_Delete func(model *DrillerModel) error
+	_Save          func(model *DrillerModel) (*DrillerModel, error)
+	_SaveContext   func(model *DrillerModel, ctx context.Context) (*DrillerModel, error)
+	_Delete        func(model *DrillerModel) error
+	_DeleteContext func(model *DrillerModel, ctx context.Context) error
 }
 
 // Init Initializes spec and default backing functions for model instance
@@ -34,7 +36,15 @@ func (model *DrillerModel) Init(spec *ServiceSpec) *DrillerModel {
 		model._Save = serviceMock.MockFunc.(func(model *DrillerModel) (*DrillerModel, error))
 	} else {
 		model._Save = func(model *DrillerModel) (*DrillerModel, error) {
-			return nil, errors.New("not implemented")
+			return model._SaveContext(model, context.Background())
+		}
+	}
+
+	if serviceMock, ok := spec.ModelServiceCallMocks["SaveContext"]; ok {
+		model._SaveContext = serviceMock.MockFunc.(func(model *DrillerModel, ctx context.Context) (*DrillerModel, error))
+	} else {
+		model._SaveContext = func(model *DrillerModel, ctx context.Context) (*DrillerModel, error) {
+			return nil, ErrNotImplemented
 		}
 	}
 
@@ -42,7 +52,15 @@ func (model *DrillerModel) Init(spec *ServiceSpec) *DrillerModel {
 		model._Delete = serviceMock.MockFunc.(func(model *DrillerModel) error)
 	} else {
 		model._Delete = func(model *DrillerModel) error {
-			return errors.New("not implemented")
+			return model._DeleteContext(model, context.Background())
+		}
+	}
+
+	if serviceMock, ok := spec.ModelServiceCallMocks["DeleteContext"]; ok {
+		model._DeleteContext = serviceMock.MockFunc.(func(model *DrillerModel, ctx context.Context) error)
+	} else {
+		model._DeleteContext = func(model *DrillerModel, ctx context.Context) error {
+			return ErrNotImplemented
 		}
 	}
 	return model
@@ -58,11 +76,21 @@ func (model *DrillerModel) Save() (*DrillerModel, error) {
 	return model._Save(model)
 }
 
+// SaveContext save changed model using the provided context
+func (model *DrillerModel) SaveContext(ctx context.Context) (*DrillerModel, error) {
+	return model._SaveContext(model, ctx)
+}
+
 // Delete model
 func (model *DrillerModel) Delete() error {
 	return model._Delete(model)
 }
 
+// DeleteContext delete model using the provided context
+func (model *DrillerModel) DeleteContext(ctx context.Context) error {
+	return model._DeleteContext(model, ctx)
+}
+
 // DrillerPhoneNumberModel phone number model for driller association
 type DrillerPhoneNumberModel struct {
 	*PhoneNumberModel
@@ -1,10 +1,8 @@
 package hydros
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"reflect"
 )
@@ -26,18 +24,26 @@ type DrillerService interface {
 	Service
 
 	Get(ID uint) (*DrillerModel, error)
+	GetContext(ctx context.Context, ID uint) (*DrillerModel, error)
 	Count() (int, error)
+	CountContext(ctx context.Context) (int, error)
 	List(from int, size int, sort []Sort, ids []uint) ([]*DrillerModel, error)
+	ListContext(ctx context.Context, from int, size int, sort []Sort, ids []uint) ([]*DrillerModel, error)
 	Create(model *DrillerModel) (*DrillerModel, error)
+	CreateContext(ctx context.Context, model *DrillerModel) (*DrillerModel, error)
 }
 
 // DefaultDrillerService default driller service struct that contains backing functions
 type DefaultDrillerService struct {
 	*DefaultService
-	GetFunc    func(ID uint) (*DrillerModel, error)
-	CountFunc  func() (int, error)
-	ListFunc   func(from int, size int, sort []Sort, ids []uint) ([]*DrillerModel, error)
-	CreateFunc func(model *DrillerModel) (*DrillerModel, error)
+	GetFunc           func(ID uint) (*DrillerModel, error)
+	GetContextFunc    func(ctx context.Context, ID uint) (*DrillerModel, error)
+	CountFunc         func() (int, error)
+	CountContextFunc  func(ctx context.Context) (int, error)
+	ListFunc          func(from int, size int, sort []Sort, ids []uint) ([]*DrillerModel, error)
+	ListContextFunc   func(ctx context.Context, from int, size int, sort []Sort, ids []uint) ([]*DrillerModel, error)
+	CreateFunc        func(model *DrillerModel) (*DrillerModel, error)
+	CreateContextFunc func(ctx context.Context, model *DrillerModel) (*DrillerModel, error)
 }
 
 // Init Initializes spec and default backing functions for service
@@ -47,34 +53,18 @@ func (service *DefaultDrillerService) Init(spec *ServiceSpec) *DefaultDrillerSer
 
 	// Define Get backing function
 	service.GetFunc = func(ID uint) (*DrillerModel, error) {
-		uri := fmt.Sprintf("%s/%s/%d.json", service.Spec.Client.URL.String(), service.Spec.ServiceName, ID)
-		req, err := http.NewRequest("GET", uri, nil)
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
-
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
-
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp.StatusCode != 200 {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
-		}
+		return service.GetContextFunc(context.Background(), ID)
+	}
 
+	// Define GetContext backing function
+	service.GetContextFunc = func(ctx context.Context, ID uint) (*DrillerModel, error) {
 		var driller DrillerModel
-		err = json.Unmarshal(bodyBytes, &driller)
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("%s/%d.json", service.Spec.ServiceName, ID),
+			ExpectedStatus: http.StatusOK,
+			Result:         &driller,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -83,17 +73,32 @@ func (service *DefaultDrillerService) Init(spec *ServiceSpec) *DefaultDrillerSer
 
 	// Define Count backing function
 	service.CountFunc = func() (int, error) {
-		return 0, errors.New("not implemented")
+		return service.CountContextFunc(context.Background())
+	}
+
+	// Define CountContext backing function
+	service.CountContextFunc = func(ctx context.Context) (int, error) {
+		return 0, ErrNotImplemented
 	}
 
 	// Define List backing function
 	service.ListFunc = func(from int, size int, sort []Sort, ids []uint) ([]*DrillerModel, error) {
-		return nil, errors.New("not implemented")
+		return service.ListContextFunc(context.Background(), from, size, sort, ids)
+	}
+
+	// Define ListContext backing function
+	service.ListContextFunc = func(ctx context.Context, from int, size int, sort []Sort, ids []uint) ([]*DrillerModel, error) {
+		return nil, ErrNotImplemented
 	}
 
 	// Define Create backing function
-	service.CreateFunc = func(*DrillerModel) (*DrillerModel, error) {
-		return nil, errors.New("not implemented")
+	service.CreateFunc = func(model *DrillerModel) (*DrillerModel, error) {
+		return service.CreateContextFunc(context.Background(), model)
+	}
+
+	// Define CreateContext backing function
+	service.CreateContextFunc = func(ctx context.Context, model *DrillerModel) (*DrillerModel, error) {
+		return nil, ErrNotImplemented
 	}
 
 	return service
@@ -104,17 +109,37 @@ func (service *DefaultDrillerService) Get(ID uint) (*DrillerModel, error) {
 	return service.GetFunc(ID)
 }
 
+// GetContext Get payload object by id using the provided context
+func (service *DefaultDrillerService) GetContext(ctx context.Context, ID uint) (*DrillerModel, error) {
+	return service.GetContextFunc(ctx, ID)
+}
+
 // List List objects for service
 func (service *DefaultDrillerService) List(from int, size int, sort []Sort, ids []uint) ([]*DrillerModel, error) {
 	return service.ListFunc(from, size, sort, ids)
 }
 
+// ListContext List objects for service using the provided context
+func (service *DefaultDrillerService) ListContext(ctx context.Context, from int, size int, sort []Sort, ids []uint) ([]*DrillerModel, error) {
+	return service.ListContextFunc(ctx, from, size, sort, ids)
+}
+
 // Count Get a total number of objects
 func (service *DefaultDrillerService) Count() (int, error) {
 	return service.CountFunc()
 }
 
+// CountContext Get a total number of objects using the provided context
+func (service *DefaultDrillerService) CountContext(ctx context.Context) (int, error) {
+	return service.CountContextFunc(ctx)
+}
+
 // Create Create new
 func (service *DefaultDrillerService) Create(model *DrillerModel) (*DrillerModel, error) {
 	return service.CreateFunc(model)
 }
+
+// CreateContext Create new using the provided context
+func (service *DefaultDrillerService) CreateContext(ctx context.Context, model *DrillerModel) (*DrillerModel, error) {
+	return service.CreateContextFunc(ctx, model)
+}
@@ -1,7 +1,77 @@
 package hydros
 
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotImplemented returned by backing functions that have no API implementation
+var ErrNotImplemented = errors.New("not implemented")
+
+// ErrEmptyResponse returned when a successful response has no body, or no model, to decode into the expected result
+var ErrEmptyResponse = errors.New("response body is empty")
+
 // ErrorResponse error response payload
 type ErrorResponse struct {
 	Message     string `json:"message"`
 	Description string `json:"description"`
 }
+
+// APIError error returned when the API responds with an unexpected status code
+type APIError struct {
+	// StatusCode HTTP status code of the response
+	StatusCode int
+	// ErrorResponse decoded error payload, nil when the body could not be decoded
+	ErrorResponse *ErrorResponse
+	// Body raw response body
+	Body []byte
+	// Method HTTP method of the request
+	Method string
+	// URL request URL
+	URL string
+	// RequestID value of the X-Request-Id response header, if any
+	RequestID string
+}
+
+// Error formats the decoded error payload when available, otherwise the status code and raw body
+func (e *APIError) Error() string {
+	if e.ErrorResponse != nil && e.ErrorResponse.Message != "" {
+		return fmt.Sprintf("%s: %s", e.ErrorResponse.Message, e.ErrorResponse.Description)
+	}
+	return fmt.Sprintf("%d error: %s", e.StatusCode, string(e.Body))
+}
+
+// IsNotFound reports whether err is an *APIError with status 404
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an *APIError with status 401
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an *APIError with status 403
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsConflict reports whether err is an *APIError with status 409
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsValidationError reports whether err is an *APIError with status 400 or 422
+func IsValidationError(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest) || hasStatusCode(err, http.StatusUnprocessableEntity)
+}
+
+// hasStatusCode checks if err wraps an *APIError with the given status code
+func hasStatusCode(err error, statusCode int) bool {
+	var apiError *APIError
+	if errors.As(err, &apiError) {
+		return apiError.StatusCode == statusCode
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package hydros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIError_Error(t *testing.T) {
+
+	err := &APIError{
+		StatusCode:    http.StatusBadRequest,
+		ErrorResponse: &ErrorResponse{Message: "invalid well", Description: "serial is required"},
+		Body:          []byte(`{"message":"invalid well","description":"serial is required"}`),
+	}
+	assert.Equal(t, "invalid well: serial is required", err.Error())
+
+	err = &APIError{StatusCode: http.StatusBadGateway, Body: []byte("bad gateway")}
+	assert.Equal(t, "502 error: bad gateway", err.Error())
+}
+
+func TestAPIError_StatusHelpers(t *testing.T) {
+
+	notFound := fmt.Errorf("fetching well: %w", &APIError{StatusCode: http.StatusNotFound})
+	assert.True(t, IsNotFound(notFound))
+	assert.False(t, IsUnauthorized(notFound))
+
+	assert.True(t, IsUnauthorized(&APIError{StatusCode: http.StatusUnauthorized}))
+	assert.True(t, IsForbidden(&APIError{StatusCode: http.StatusForbidden}))
+	assert.True(t, IsConflict(&APIError{StatusCode: http.StatusConflict}))
+	assert.True(t, IsValidationError(&APIError{StatusCode: http.StatusBadRequest}))
+	assert.True(t, IsValidationError(&APIError{StatusCode: http.StatusUnprocessableEntity}))
+	assert.False(t, IsNotFound(errors.New("404 error")))
+	assert.False(t, IsNotFound(nil))
+}
+
+func TestAPIError_FromServer(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc-123")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not found","description":"well 5 does not exist"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	well, err := client.Well.GetContext(context.Background(), 5)
+	assert.Nil(t, well)
+	assert.True(t, IsNotFound(err))
+
+	var apiError *APIError
+	if assert.True(t, errors.As(err, &apiError)) {
+		assert.Equal(t, http.StatusNotFound, apiError.StatusCode)
+		assert.Equal(t, http.MethodGet, apiError.Method)
+		assert.Equal(t, server.URL+"/wells/5.json", apiError.URL)
+		assert.Equal(t, "abc-123", apiError.RequestID)
+		assert.Equal(t, "well 5 does not exist", apiError.ErrorResponse.Description)
+	}
+}
+
+func TestErrNotImplemented(t *testing.T) {
+
+	client, err := NewClient(SetHost("https://api.somewhere.com"))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Permit.Count()
+	assert.True(t, errors.Is(err, ErrNotImplemented))
+}
@@ -0,0 +1,69 @@
+package hydros
+
+import (
+	"reflect"
+	"strings"
+)
+
+// jsonFieldName returns the JSON key of a struct field and whether the field is encoded at all
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" && !field.Anonymous {
+		return "", false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name := strings.Split(tag, ",")[0]
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+// jsonFieldType resolves a dotted JSON path (e.g. "location.county") against a struct type, descending into embedded
+// structs, pointers and slice elements
+func jsonFieldType(structType reflect.Type, path string) (reflect.Type, bool) {
+	current := structType
+	for _, key := range strings.Split(path, ".") {
+		for current.Kind() == reflect.Ptr || current.Kind() == reflect.Slice {
+			current = current.Elem()
+		}
+		if current.Kind() != reflect.Struct {
+			return nil, false
+		}
+		field, ok := findJSONField(current, key)
+		if !ok {
+			return nil, false
+		}
+		current = field.Type
+	}
+	return current, true
+}
+
+// findJSONField finds the field encoded under key, giving outer fields precedence over embedded ones
+func findJSONField(structType reflect.Type, key string) (reflect.StructField, bool) {
+	var embedded []reflect.StructField
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			embedded = append(embedded, field)
+			continue
+		}
+		if name, ok := jsonFieldName(field); ok && name == key {
+			return field, true
+		}
+	}
+	for _, field := range embedded {
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct {
+			if found, ok := findJSONField(fieldType, key); ok {
+				return found, true
+			}
+		}
+	}
+	return reflect.StructField{}, false
+}
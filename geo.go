@@ -0,0 +1,217 @@
+package hydros
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+)
+
+// EarthRadiusMeters mean earth radius used for haversine distances
+const EarthRadiusMeters = 6371008.8
+
+// metersPerFoot international foot in meters
+const metersPerFoot = 0.3048
+
+// Distance length in meters
+type Distance float64
+
+// Meters creates a distance from meters
+func Meters(meters float64) Distance {
+	return Distance(meters)
+}
+
+// Feet creates a distance from feet
+func Feet(feet float64) Distance {
+	return Distance(feet * metersPerFoot)
+}
+
+// Meters distance in meters
+func (distance Distance) Meters() float64 {
+	return float64(distance)
+}
+
+// Feet distance in feet
+func (distance Distance) Feet() float64 {
+	return float64(distance) / metersPerFoot
+}
+
+// Point WGS84 coordinate in decimal degrees
+type Point struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Haversine great-circle distance between two points
+func Haversine(from Point, to Point) Distance {
+	lat1, lat2 := toRadians(from.Latitude), toRadians(to.Latitude)
+	dLat := lat2 - lat1
+	dLon := toRadians(to.Longitude - from.Longitude)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return Distance(2 * EarthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a))))
+}
+
+// BoundingBox area between a south-west and north-east corner.  Boxes crossing the antimeridian are not supported
+type BoundingBox struct {
+	SouthWest Point `json:"southWest"`
+	NorthEast Point `json:"northEast"`
+}
+
+// Contains reports whether point lies inside or on the edge of the box
+func (box BoundingBox) Contains(point Point) bool {
+	return point.Latitude >= box.SouthWest.Latitude && point.Latitude <= box.NorthEast.Latitude &&
+		point.Longitude >= box.SouthWest.Longitude && point.Longitude <= box.NorthEast.Longitude
+}
+
+// Center midpoint of the box
+func (box BoundingBox) Center() Point {
+	return Point{
+		Latitude:  (box.SouthWest.Latitude + box.NorthEast.Latitude) / 2,
+		Longitude: (box.SouthWest.Longitude + box.NorthEast.Longitude) / 2,
+	}
+}
+
+// validate checks the corners are in order
+func (box BoundingBox) validate() error {
+	if box.SouthWest.Latitude > box.NorthEast.Latitude || box.SouthWest.Longitude > box.NorthEast.Longitude {
+		return errors.New("bounding box south-west corner must be south-west of the north-east corner")
+	}
+	return nil
+}
+
+// Polygon closed ring of points.  The last point connects back to the first
+type Polygon []Point
+
+// Contains reports whether point lies inside the polygon using ray casting
+func (polygon Polygon) Contains(point Point) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Latitude > point.Latitude) != (b.Latitude > point.Latitude) &&
+			point.Longitude < (b.Longitude-a.Longitude)*(point.Latitude-a.Latitude)/(b.Latitude-a.Latitude)+a.Longitude {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Center average of the polygon's vertices
+func (polygon Polygon) Center() Point {
+	var center Point
+	for _, point := range polygon {
+		center.Latitude += point.Latitude
+		center.Longitude += point.Longitude
+	}
+	if len(polygon) > 0 {
+		center.Latitude /= float64(len(polygon))
+		center.Longitude /= float64(len(polygon))
+	}
+	return center
+}
+
+// Point location of the well, if it has coordinates
+func (model *WellModel) Point() (Point, bool) {
+	if model.Location == nil || !model.Location.Latitude.Valid || !model.Location.Longitude.Valid {
+		return Point{}, false
+	}
+	return Point{Latitude: model.Location.Latitude.Float64, Longitude: model.Location.Longitude.Float64}, true
+}
+
+// WellDistance well found by a geospatial search and its distance from the search center
+type WellDistance struct {
+	Well     *WellModel
+	Distance Distance
+}
+
+// SearchWithinRadius finds wells within radius of center, nearest first.  Wells are filtered client-side while paging
+// through query, so narrow query (e.g. by county) where possible.  A nil query scans all wells
+func (service *DefaultWellService) SearchWithinRadius(center Point, radius Distance, query *WellSearchQuery) ([]*WellDistance, error) {
+	return service.SearchWithinRadiusFunc(center, radius, query)
+}
+
+// SearchWithinRadiusContext finds wells within radius of center using the provided context
+func (service *DefaultWellService) SearchWithinRadiusContext(ctx context.Context, center Point, radius Distance, query *WellSearchQuery) ([]*WellDistance, error) {
+	return service.SearchWithinRadiusContextFunc(ctx, center, radius, query)
+}
+
+// SearchWithinBoundingBox finds wells inside box, ordered by distance from the box center.  See SearchWithinRadius
+func (service *DefaultWellService) SearchWithinBoundingBox(box BoundingBox, query *WellSearchQuery) ([]*WellDistance, error) {
+	return service.SearchWithinBoundingBoxFunc(box, query)
+}
+
+// SearchWithinBoundingBoxContext finds wells inside box using the provided context
+func (service *DefaultWellService) SearchWithinBoundingBoxContext(ctx context.Context, box BoundingBox, query *WellSearchQuery) ([]*WellDistance, error) {
+	return service.SearchWithinBoundingBoxContextFunc(ctx, box, query)
+}
+
+// SearchWithinPolygon finds wells inside polygon, ordered by distance from its center.  See SearchWithinRadius
+func (service *DefaultWellService) SearchWithinPolygon(polygon Polygon, query *WellSearchQuery) ([]*WellDistance, error) {
+	return service.SearchWithinPolygonFunc(polygon, query)
+}
+
+// SearchWithinPolygonContext finds wells inside polygon using the provided context
+func (service *DefaultWellService) SearchWithinPolygonContext(ctx context.Context, polygon Polygon, query *WellSearchQuery) ([]*WellDistance, error) {
+	return service.SearchWithinPolygonContextFunc(ctx, polygon, query)
+}
+
+// searchWithinRadius default SearchWithinRadiusContext backing function
+func (service *DefaultWellService) searchWithinRadius(ctx context.Context, center Point, radius Distance, query *WellSearchQuery) ([]*WellDistance, error) {
+	if radius <= 0 {
+		return nil, errors.New("radius must be greater than 0")
+	}
+	return service.searchWithin(ctx, center, query, func(point Point, distance Distance) bool {
+		return distance <= radius
+	})
+}
+
+// searchWithinBoundingBox default SearchWithinBoundingBoxContext backing function
+func (service *DefaultWellService) searchWithinBoundingBox(ctx context.Context, box BoundingBox, query *WellSearchQuery) ([]*WellDistance, error) {
+	if err := box.validate(); err != nil {
+		return nil, err
+	}
+	return service.searchWithin(ctx, box.Center(), query, func(point Point, distance Distance) bool {
+		return box.Contains(point)
+	})
+}
+
+// searchWithinPolygon default SearchWithinPolygonContext backing function
+func (service *DefaultWellService) searchWithinPolygon(ctx context.Context, polygon Polygon, query *WellSearchQuery) ([]*WellDistance, error) {
+	if len(polygon) < 3 {
+		return nil, errors.New("polygon must have at least 3 points")
+	}
+	return service.searchWithin(ctx, polygon.Center(), query, func(point Point, distance Distance) bool {
+		return polygon.Contains(point)
+	})
+}
+
+// searchWithin pages through query keeping wells accepted by match, ordered by distance from center
+func (service *DefaultWellService) searchWithin(ctx context.Context, center Point, query *WellSearchQuery,
+	match func(point Point, distance Distance) bool) ([]*WellDistance, error) {
+
+	var found []*WellDistance
+	it := service.SearchAllContextFunc(ctx, query, SetIteratorPrefetch(true))
+	for it.Next() {
+		well := it.Well()
+		point, ok := well.Point()
+		if !ok {
+			continue
+		}
+		distance := Haversine(center, point)
+		if match(point, distance) {
+			found = append(found, &WellDistance{Well: well, Distance: distance})
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].Distance < found[j].Distance
+	})
+	return found, nil
+}
+
+// toRadians converts degrees to radians
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
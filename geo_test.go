@@ -0,0 +1,104 @@
+package hydros
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHaversine(t *testing.T) {
+
+	austin := Point{Latitude: 30.2672, Longitude: -97.7431}
+	dallas := Point{Latitude: 32.7767, Longitude: -96.7970}
+	assert.InDelta(t, 292000, Haversine(austin, dallas).Meters(), 1500)
+	assert.InDelta(t, 0, Haversine(austin, austin).Meters(), 1e-9)
+
+	assert.InDelta(t, 1000, Feet(1000).Feet(), 1e-9)
+	assert.InDelta(t, 304.8, Feet(1000).Meters(), 1e-9)
+}
+
+func TestShapes_Contains(t *testing.T) {
+
+	box := BoundingBox{SouthWest: Point{Latitude: 36, Longitude: -101}, NorthEast: Point{Latitude: 37, Longitude: -100}}
+	assert.True(t, box.Contains(Point{Latitude: 36.5, Longitude: -100.5}))
+	assert.False(t, box.Contains(Point{Latitude: 35.5, Longitude: -100.5}))
+	assert.Equal(t, Point{Latitude: 36.5, Longitude: -100.5}, box.Center())
+
+	triangle := Polygon{{Latitude: 0, Longitude: 0}, {Latitude: 0, Longitude: 10}, {Latitude: 10, Longitude: 0}}
+	assert.True(t, triangle.Contains(Point{Latitude: 2, Longitude: 2}))
+	assert.False(t, triangle.Contains(Point{Latitude: 8, Longitude: 8}))
+}
+
+func TestWellService_SearchWithin(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "county:Ochiltree", r.URL.Query().Get("filters"))
+		wells := []string{
+			`{"id":1,"location":{"latitude":36.2,"longitude":-100.8}}`,
+			`{"id":2,"location":{"latitude":36.201,"longitude":-100.8}}`,
+			`{"id":3,"location":{"latitude":36.3,"longitude":-100.8}}`,
+			`{"id":4,"location":{"latitude":null,"longitude":null}}`,
+			`{"id":5}`,
+		}
+		_, _ = fmt.Fprintf(w, `{"total":5,"results":[%s]}`, strings.Join(wells, ","))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+	query := NewWellSearchQuery().County("Ochiltree")
+
+	found, err := client.Well.SearchWithinRadiusContext(context.Background(), Point{Latitude: 36.2005, Longitude: -100.8}, Feet(1000), query)
+	assert.Nil(t, err, "Error should be nil.")
+	if assert.Len(t, found, 2) {
+		assert.InDelta(t, 182.4, found[0].Distance.Feet(), 1)
+		assert.InDelta(t, 55.6, found[0].Distance.Meters(), 0.5)
+	}
+
+	found, err = client.Well.SearchWithinRadiusContext(context.Background(), Point{Latitude: 36.2, Longitude: -100.8}, Feet(1000), query)
+	assert.Nil(t, err, "Error should be nil.")
+	if assert.Len(t, found, 2) {
+		assert.Equal(t, uint(1), found[0].Well.ID)
+		assert.Equal(t, uint(2), found[1].Well.ID)
+	}
+
+	found, err = client.Well.SearchWithinBoundingBoxContext(context.Background(),
+		BoundingBox{SouthWest: Point{Latitude: 36.25, Longitude: -101}, NorthEast: Point{Latitude: 36.35, Longitude: -100}}, query)
+	assert.Nil(t, err, "Error should be nil.")
+	if assert.Len(t, found, 1) {
+		assert.Equal(t, uint(3), found[0].Well.ID)
+	}
+
+	found, err = client.Well.SearchWithinPolygonContext(context.Background(),
+		Polygon{{Latitude: 36.1, Longitude: -101}, {Latitude: 36.25, Longitude: -101}, {Latitude: 36.25, Longitude: -100}}, query)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Len(t, found, 2)
+
+	_, err = client.Well.SearchWithinRadius(Point{}, 0, nil)
+	assert.NotNil(t, err, "Error should not be nil.")
+	_, err = client.Well.SearchWithinPolygon(Polygon{{}, {}}, nil)
+	assert.NotNil(t, err, "Error should not be nil.")
+	_, err = client.Well.SearchWithinBoundingBox(BoundingBox{SouthWest: Point{Latitude: 1}, NorthEast: Point{Latitude: 0}}, nil)
+	assert.NotNil(t, err, "Error should not be nil.")
+}
+
+func TestWellService_SearchWithinMock(t *testing.T) {
+
+	client, err := NewClient()
+	assert.Nil(t, err, "Error should be nil.")
+
+	nearby := []*WellDistance{{Well: &WellModel{DefaultModelBase: &DefaultModelBase{ID: 3}}, Distance: Feet(120)}}
+	err = MockServiceMethod(client, "Well.SearchWithinRadiusContext",
+		func(ctx context.Context, center Point, radius Distance, query *WellSearchQuery) ([]*WellDistance, error) {
+			return nearby, nil
+		})
+	assert.Nil(t, err, "Error should be nil.")
+
+	found, err := client.Well.SearchWithinRadius(Point{Latitude: 36.2, Longitude: -100.8}, Feet(1000), nil)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, nearby, found)
+}
@@ -1,10 +1,8 @@
 package hydros
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"reflect"
 )
@@ -25,16 +23,22 @@ type HistoryService interface {
 	Service
 
 	Get(updateID string) (*HistoryModel, error)
+	GetContext(ctx context.Context, updateID string) (*HistoryModel, error)
 	Count() (int, error)
+	CountContext(ctx context.Context) (int, error)
 	List(from int, size int, sort []Sort, updateIds []string, modelType string) ([]*HistoryModel, error)
+	ListContext(ctx context.Context, from int, size int, sort []Sort, updateIds []string, modelType string) ([]*HistoryModel, error)
 }
 
 // DefaultHistoryService default history service struct that contains backing functions
 type DefaultHistoryService struct {
 	*DefaultService
-	GetFunc   func(updateID string) (*HistoryModel, error)
-	CountFunc func() (int, error)
-	ListFunc  func(from int, size int, sort []Sort, updateIds []string, modelType string) ([]*HistoryModel, error)
+	GetFunc          func(updateID string) (*HistoryModel, error)
+	GetContextFunc   func(ctx context.Context, updateID string) (*HistoryModel, error)
+	CountFunc        func() (int, error)
+	CountContextFunc func(ctx context.Context) (int, error)
+	ListFunc         func(from int, size int, sort []Sort, updateIds []string, modelType string) ([]*HistoryModel, error)
+	ListContextFunc  func(ctx context.Context, from int, size int, sort []Sort, updateIds []string, modelType string) ([]*HistoryModel, error)
 }
 
 // Init initialized spec and default backing functions for service
@@ -43,34 +47,18 @@ func (service *DefaultHistoryService) Init(spec *ServiceSpec) *DefaultHistorySer
 
 	// Define GetByUpdateID backing function
 	service.GetFunc = func(updateID string) (*HistoryModel, error) {
-		uri := fmt.Sprintf("%s/%s/%s.json", service.Spec.Client.URL.String(), service.Spec.ServiceName, updateID)
-		req, err := http.NewRequest("GET", uri, nil)
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
-
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
-
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp.StatusCode != 200 {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
-		}
+		return service.GetContextFunc(context.Background(), updateID)
+	}
 
+	// Define GetContext backing function
+	service.GetContextFunc = func(ctx context.Context, updateID string) (*HistoryModel, error) {
 		var history HistoryModel
-		err = json.Unmarshal(bodyBytes, &history)
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("%s/%s.json", service.Spec.ServiceName, updateID),
+			ExpectedStatus: http.StatusOK,
+			Result:         &history,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -79,12 +67,22 @@ func (service *DefaultHistoryService) Init(spec *ServiceSpec) *DefaultHistorySer
 
 	// Define Count backing function
 	service.CountFunc = func() (int, error) {
-		return 0, errors.New("not implemented")
+		return service.CountContextFunc(context.Background())
+	}
+
+	// Define CountContext backing function
+	service.CountContextFunc = func(ctx context.Context) (int, error) {
+		return 0, ErrNotImplemented
 	}
 
 	// Define List backing function
 	service.ListFunc = func(from int, size int, sort []Sort, updateIds []string, modelType string) ([]*HistoryModel, error) {
-		return nil, errors.New("not implemented")
+		return service.ListContextFunc(context.Background(), from, size, sort, updateIds, modelType)
+	}
+
+	// Define ListContext backing function
+	service.ListContextFunc = func(ctx context.Context, from int, size int, sort []Sort, updateIds []string, modelType string) ([]*HistoryModel, error) {
+		return nil, ErrNotImplemented
 	}
 
 	return service
@@ -95,12 +93,27 @@ func (service *DefaultHistoryService) Get(updateID string) (*HistoryModel, error
 	return service.GetFunc(updateID)
 }
 
+// GetContext payload object by id using the provided context
+func (service *DefaultHistoryService) GetContext(ctx context.Context, updateID string) (*HistoryModel, error) {
+	return service.GetContextFunc(ctx, updateID)
+}
+
 // List object for service
 func (service *DefaultHistoryService) List(from int, size int, sort []Sort, updateIds []string, modelType string) ([]*HistoryModel, error) {
 	return service.ListFunc(from, size, sort, updateIds, modelType)
 }
 
+// ListContext object for service using the provided context
+func (service *DefaultHistoryService) ListContext(ctx context.Context, from int, size int, sort []Sort, updateIds []string, modelType string) ([]*HistoryModel, error) {
+	return service.ListContextFunc(ctx, from, size, sort, updateIds, modelType)
+}
+
 // Count get a total number of objects
 func (service *DefaultHistoryService) Count() (int, error) {
 	return service.CountFunc()
 }
+
+// CountContext get a total number of objects using the provided context
+func (service *DefaultHistoryService) CountContext(ctx context.Context) (int, error) {
+	return service.CountContextFunc(ctx)
+}
@@ -0,0 +1,186 @@
+package hydros
+
+import (
+	"context"
+	"fmt"
+)
+
+// iteratorOptions paging settings shared by iterators
+type iteratorOptions struct {
+	pageSize int
+	prefetch bool
+}
+
+// IteratorOptionFunc iterator option
+type IteratorOptionFunc func(*iteratorOptions)
+
+// SetIteratorPageSize number of results fetched per request, at most MaxPageSize.  Defaults to MaxPageSize
+func SetIteratorPageSize(pageSize int) IteratorOptionFunc {
+	return func(options *iteratorOptions) {
+		options.pageSize = pageSize
+	}
+}
+
+// SetIteratorPrefetch fetches the next page in the background while the current page is consumed
+func SetIteratorPrefetch(prefetch bool) IteratorOptionFunc {
+	return func(options *iteratorOptions) {
+		options.prefetch = prefetch
+	}
+}
+
+// pageFetcher fetches the page of at most size results starting at from.  total is -1 when unknown
+type pageFetcher func(ctx context.Context, from int, size int) (items []interface{}, total int, err error)
+
+// page single fetched page
+type page struct {
+	items []interface{}
+	total int
+	err   error
+}
+
+// pager lazily walks the pages returned by fetch
+type pager struct {
+	ctx     context.Context
+	fetch   pageFetcher
+	options iteratorOptions
+
+	from    int
+	items   []interface{}
+	current interface{}
+	total   int
+	last    bool
+	pending chan page
+	err     error
+}
+
+// newPager creates a pager starting at from
+func newPager(ctx context.Context, from int, fetch pageFetcher, options []IteratorOptionFunc) *pager {
+	p := &pager{ctx: ctx, fetch: fetch, from: from, total: -1, options: iteratorOptions{pageSize: MaxPageSize}}
+	for _, option := range options {
+		option(&p.options)
+	}
+	if p.options.pageSize < 1 || p.options.pageSize > MaxPageSize {
+		p.err = fmt.Errorf("iterator page size must be between 1 and %d", MaxPageSize)
+	}
+	return p
+}
+
+// next advances to the next item, fetching pages as needed
+func (p *pager) next() bool {
+	if p.err != nil {
+		return false
+	}
+	for len(p.items) == 0 {
+		if p.last {
+			return false
+		}
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+		if !p.load() {
+			return false
+		}
+	}
+	p.current, p.items = p.items[0], p.items[1:]
+	return true
+}
+
+// load receives the next page, either prefetched or fetched now
+func (p *pager) load() bool {
+	var result page
+	if p.pending != nil {
+		select {
+		case result = <-p.pending:
+		case <-p.ctx.Done():
+			p.err = p.ctx.Err()
+			return false
+		}
+		p.pending = nil
+	} else {
+		result = p.fetchPage(p.from)
+	}
+	if result.err != nil {
+		p.err = result.err
+		return false
+	}
+
+	p.items = result.items
+	p.total = result.total
+	p.from += len(result.items)
+	p.last = len(result.items) == 0 || len(result.items) < p.options.pageSize ||
+		(p.total >= 0 && p.from >= p.total)
+
+	if p.options.prefetch && !p.last {
+		p.pending = make(chan page, 1)
+		go func(pending chan page, from int) {
+			pending <- p.fetchPage(from)
+		}(p.pending, p.from)
+	}
+	return true
+}
+
+// fetchPage fetches the page starting at from
+func (p *pager) fetchPage(from int) page {
+	items, total, err := p.fetch(p.ctx, from, p.options.pageSize)
+	return page{items: items, total: total, err: err}
+}
+
+// WellIterator pages through wells one at a time:
+//
+//	it := client.Well.SearchAllContext(ctx, query)
+//	for it.Next() {
+//		well := it.Well()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type WellIterator struct {
+	pager *pager
+}
+
+// Next advances to the next well, returning false when there are no more wells or an error occurred
+func (it *WellIterator) Next() bool {
+	return it.pager.next()
+}
+
+// Well current well
+func (it *WellIterator) Well() *WellModel {
+	well, _ := it.pager.current.(*WellModel)
+	return well
+}
+
+// Total total number of matches reported by the API, or -1 when unknown
+func (it *WellIterator) Total() int {
+	return it.pager.total
+}
+
+// Err error that stopped iteration, if any.  Iteration stops with ctx.Err() when the context is done
+func (it *WellIterator) Err() error {
+	return it.pager.err
+}
+
+// MeterReadingIterator pages through meter readings one at a time
+type MeterReadingIterator struct {
+	pager *pager
+}
+
+// Next advances to the next meter reading, returning false when there are no more readings or an error occurred
+func (it *MeterReadingIterator) Next() bool {
+	return it.pager.next()
+}
+
+// MeterReading current meter reading
+func (it *MeterReadingIterator) MeterReading() *MeterReadingModel {
+	reading, _ := it.pager.current.(*MeterReadingModel)
+	return reading
+}
+
+// Err error that stopped iteration, if any.  Iteration stops with ctx.Err() when the context is done
+func (it *MeterReadingIterator) Err() error {
+	return it.pager.err
+}
+
+// failedPager pager stopped before fetching with err
+func failedPager(err error) *pager {
+	return &pager{err: err, total: -1}
+}
@@ -0,0 +1,174 @@
+package hydros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// pagedWellServer serves total wells from the search and list endpoints
+func pagedWellServer(total int, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		from, _ := strconv.Atoi(r.URL.Query().Get("from"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		var wells []string
+		for id := from + 1; id <= from+size && id <= total; id++ {
+			wells = append(wells, fmt.Sprintf(`{"id":%d}`, id))
+		}
+		if strings.HasSuffix(r.URL.Path, "search.json") {
+			_, _ = fmt.Fprintf(w, `{"total":%d,"results":[%s]}`, total, strings.Join(wells, ","))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `[%s]`, strings.Join(wells, ","))
+	}))
+}
+
+func TestWellService_SearchAll(t *testing.T) {
+
+	for _, prefetch := range []bool{false, true} {
+		var requests int32
+		server := pagedWellServer(7, &requests)
+
+		client, err := NewClient(SetHost(server.URL))
+		assert.Nil(t, err, "Error should be nil.")
+
+		it := client.Well.SearchAllContext(context.Background(), NewWellSearchQuery().County("Ochiltree"),
+			SetIteratorPageSize(3), SetIteratorPrefetch(prefetch))
+		var ids []uint
+		for it.Next() {
+			ids = append(ids, it.Well().ID)
+			assert.NotNil(t, it.Well().Spec, "Returned models should be initialized")
+		}
+		assert.Nil(t, it.Err(), "Error should be nil.")
+		assert.Equal(t, []uint{1, 2, 3, 4, 5, 6, 7}, ids)
+		assert.Equal(t, 7, it.Total())
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+		server.Close()
+	}
+}
+
+func TestWellService_ListAll(t *testing.T) {
+
+	var requests int32
+	server := pagedWellServer(6, &requests)
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	it := client.Well.ListAll(nil, nil, SetIteratorPageSize(3))
+	count := 0
+	for it.Next() {
+		count++
+	}
+	assert.Nil(t, it.Err(), "Error should be nil.")
+	assert.Equal(t, 6, count)
+	assert.Equal(t, -1, it.Total())
+	// A full last page needs one more request to find the end
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestWellIterator_Canceled(t *testing.T) {
+
+	var requests int32
+	server := pagedWellServer(100, &requests)
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	it := client.Well.SearchAllContext(ctx, nil, SetIteratorPageSize(10), SetIteratorPrefetch(true))
+	count := 0
+	for it.Next() {
+		count++
+		if count == 15 {
+			cancel()
+		}
+	}
+	assert.True(t, errors.Is(it.Err(), context.Canceled), "Error should be context.Canceled")
+	assert.Equal(t, 20, count)
+}
+
+func TestWellIterator_Errors(t *testing.T) {
+
+	client, err := NewClient()
+	assert.Nil(t, err, "Error should be nil.")
+
+	it := client.Well.SearchAllContext(context.Background(), nil, SetIteratorPageSize(MaxPageSize+1))
+	assert.False(t, it.Next())
+	assert.EqualError(t, it.Err(), "iterator page size must be between 1 and 150")
+
+	it = client.Well.SearchAllContext(context.Background(), NewWellSearchQuery().SortAsc("nope"))
+	assert.False(t, it.Next())
+	assert.EqualError(t, it.Err(), "invalid well search query: unknown sort field 'nope'")
+
+	failure := errors.New("boom")
+	client.Well.(*DefaultWellService).ListContextFunc = func(ctx context.Context, from int, size int, sort []Sort, ids []uint) ([]*WellModel, error) {
+		return nil, failure
+	}
+	it = client.Well.ListAllContext(context.Background(), nil, nil)
+	assert.False(t, it.Next())
+	assert.Equal(t, failure, it.Err())
+}
+
+func TestMeterReadingService_ListAllByWellAndMeter(t *testing.T) {
+
+	client, err := NewClient()
+	assert.Nil(t, err, "Error should be nil.")
+
+	// Reading lists have no default implementation, the iterator pages through the backing function
+	it := client.MeterReading.ListAllByWellAndMeterContext(context.Background(), 4, 2, nil, nil, nil)
+	assert.False(t, it.Next())
+	assert.Equal(t, ErrNotImplemented, it.Err())
+
+	readingPages := [][]MeterReadingModel{
+		{{DefaultModelBase: &DefaultModelBase{ID: 1}, Reading: 10}, {DefaultModelBase: &DefaultModelBase{ID: 2}, Reading: 20}},
+		{{DefaultModelBase: &DefaultModelBase{ID: 3}, Reading: 30}},
+	}
+	err = MockServiceMethod(client, "MeterReading.ListByWellAndMeterContext",
+		func(ctx context.Context, wellID uint, meterID uint, from int, size int, sort []Sort, startDate *time.Time,
+			endDate *time.Time) ([]MeterReadingModel, error) {
+			assert.Equal(t, uint(4), wellID)
+			assert.Equal(t, uint(2), meterID)
+			assert.Equal(t, 2, size)
+			return readingPages[from/size], nil
+		})
+	assert.Nil(t, err, "Error should be nil.")
+
+	it = client.MeterReading.ListAllByWellAndMeterContext(context.Background(), 4, 2, nil, nil, nil, SetIteratorPageSize(2))
+	var readings []float64
+	for it.Next() {
+		readings = append(readings, it.MeterReading().Reading)
+	}
+	assert.Nil(t, it.Err(), "Error should be nil.")
+	assert.Equal(t, []float64{10, 20, 30}, readings)
+}
+
+func TestWellService_SearchAllMock(t *testing.T) {
+
+	client, err := NewClient()
+	assert.Nil(t, err, "Error should be nil.")
+
+	failure := errors.New("mocked")
+	err = MockServiceMethod(client, "Well.SearchAllContext",
+		func(ctx context.Context, query *WellSearchQuery, options ...IteratorOptionFunc) *WellIterator {
+			return &WellIterator{pager: failedPager(failure)}
+		})
+	assert.Nil(t, err, "Error should be nil.")
+
+	// The non-context variant delegates to the mocked context variant
+	it := client.Well.SearchAll(nil)
+	assert.False(t, it.Next())
+	assert.Equal(t, failure, it.Err())
+}
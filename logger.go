@@ -0,0 +1,149 @@
+package hydros
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxLoggedBodyBytes bodies longer than this are truncated in debug logs
+const maxLoggedBodyBytes = 64 * 1024
+
+// redacted replacement for sensitive values in logs
+const redacted = "[REDACTED]"
+
+// Logger structured, leveled logger taking alternating key/value pairs.  *slog.Logger from log/slog implements Logger
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// SetLogger logs method, URL, status and latency of every request made by services and models
+func SetLogger(logger Logger) ClientOptionFunc {
+	return func(c *Client) error {
+		c.Logger = logger
+		return nil
+	}
+}
+
+// SetDebug additionally logs request and response headers and bodies at debug level.  Credentials are masked
+func SetDebug(debug bool) ClientOptionFunc {
+	return func(c *Client) error {
+		c.Debug = debug
+		return nil
+	}
+}
+
+// sensitiveHeaders headers masked in debug logs
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", APIKeyHeader}
+
+// sensitiveFields JSON object keys masked in debug logs
+var sensitiveFields = map[string]bool{
+	"password":      true,
+	"secret":        true,
+	"client_secret": true,
+	"access_token":  true,
+	"refresh_token": true,
+	"accesstoken":   true,
+	"refreshtoken":  true,
+	"apikey":        true,
+	"api_key":       true,
+}
+
+// logAttempt logs the outcome of a single request attempt
+func (client *Client) logAttempt(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte,
+	err error, attempt int, latency time.Duration) {
+
+	if client.Logger == nil || req == nil {
+		return
+	}
+
+	args := []interface{}{
+		"method", req.Method,
+		"url", req.URL.Redacted(),
+		"attempt", attempt,
+		"latency", latency,
+	}
+	if err != nil {
+		client.Logger.Error("hydros request failed", append(args, "error", err.Error())...)
+		return
+	}
+	args = append(args, "status", resp.StatusCode)
+	if requestID := resp.Header.Get("X-Request-Id"); requestID != "" {
+		args = append(args, "requestId", requestID)
+	}
+	if resp.StatusCode >= 400 {
+		client.Logger.Warn("hydros request", args...)
+	} else {
+		client.Logger.Info("hydros request", args...)
+	}
+
+	if client.Debug {
+		client.Logger.Debug("hydros request dump",
+			"method", req.Method,
+			"url", req.URL.Redacted(),
+			"requestHeaders", client.redactHeaders(req.Header),
+			"requestBody", redactBody(reqBody),
+			"status", resp.StatusCode,
+			"responseHeaders", client.redactHeaders(resp.Header),
+			"responseBody", redactBody(respBody))
+	}
+}
+
+// redactHeaders copies headers masking credentials, including a custom auth header
+func (client *Client) redactHeaders(header http.Header) map[string]string {
+	masked := make(map[string]string, len(header))
+	for key, values := range header {
+		masked[key] = strings.Join(values, ", ")
+	}
+	sensitive := sensitiveHeaders
+	if client.AuthType == AuthTypeCustomHeader && client.AuthHeader.Key != "" {
+		sensitive = append([]string{client.AuthHeader.Key}, sensitive...)
+	}
+	for _, key := range sensitive {
+		canonicalKey := http.CanonicalHeaderKey(key)
+		if _, ok := masked[canonicalKey]; ok {
+			masked[canonicalKey] = redacted
+		}
+	}
+	return masked
+}
+
+// redactBody masks sensitive fields of JSON bodies and truncates large bodies
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		if redactedBytes, err := json.Marshal(redactValue(decoded)); err == nil {
+			body = redactedBytes
+		}
+	}
+	if len(body) > maxLoggedBodyBytes {
+		return string(body[:maxLoggedBodyBytes]) + "...(truncated)"
+	}
+	return string(body)
+}
+
+// redactValue recursively masks sensitive keys of decoded JSON
+func redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typed {
+			if sensitiveFields[strings.ToLower(key)] {
+				typed[key] = redacted
+			} else {
+				typed[key] = redactValue(nested)
+			}
+		}
+	case []interface{}:
+		for i, nested := range typed {
+			typed[i] = redactValue(nested)
+		}
+	}
+	return value
+}
@@ -0,0 +1,144 @@
+package hydros
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type logEntry struct {
+	level string
+	msg   string
+	attrs map[string]interface{}
+}
+
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (logger *recordingLogger) record(level string, msg string, args []interface{}) {
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	attrs := make(map[string]interface{})
+	for i := 0; i+1 < len(args); i += 2 {
+		attrs[args[i].(string)] = args[i+1]
+	}
+	logger.entries = append(logger.entries, logEntry{level: level, msg: msg, attrs: attrs})
+}
+
+func (logger *recordingLogger) Debug(msg string, args ...interface{}) {
+	logger.record("debug", msg, args)
+}
+func (logger *recordingLogger) Info(msg string, args ...interface{}) {
+	logger.record("info", msg, args)
+}
+func (logger *recordingLogger) Warn(msg string, args ...interface{}) {
+	logger.record("warn", msg, args)
+}
+func (logger *recordingLogger) Error(msg string, args ...interface{}) {
+	logger.record("error", msg, args)
+}
+
+func TestSetLogger(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		_, _ = w.Write([]byte(`{"total":0,"results":[]}`))
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client, err := NewClient(SetHost(server.URL), SetAccessToken("secret-token"), SetLogger(logger))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Well.Search("smith", []string{"county:Ochiltree"}, 10, 25, []Sort{{Field: "serial", Direction: Asc}})
+	assert.Nil(t, err, "Error should be nil.")
+
+	if assert.Len(t, logger.entries, 1) {
+		entry := logger.entries[0]
+		assert.Equal(t, "info", entry.level)
+		assert.Equal(t, http.MethodGet, entry.attrs["method"])
+		assert.Equal(t, http.StatusOK, entry.attrs["status"])
+		assert.Equal(t, "req-1", entry.attrs["requestId"])
+		assert.Contains(t, entry.attrs, "latency")
+		url := entry.attrs["url"].(string)
+		assert.True(t, strings.Contains(url, "sort=serial%3Aasc"), url)
+		assert.True(t, strings.Contains(url, "filters=county%3AOchiltree"), url)
+		assert.True(t, strings.Contains(url, "from=10"), url)
+		assert.True(t, strings.Contains(url, "size=25"), url)
+	}
+}
+
+func TestSetDebug_RedactsCredentials(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"id":9,"name":"Smith 1","contacts":[{"password":"hunter2"}]}`))
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client, err := NewClient(SetHost(server.URL), SetAccessToken("secret-token"), SetLogger(logger), SetDebug(true))
+	assert.Nil(t, err, "Error should be nil.")
+
+	well := (&WellModel{DefaultModelBase: &DefaultModelBase{ID: 9}}).Init(client.Well._ServiceSpec())
+	_, err = well.Update([]byte(`{"name":"Smith 1","apiKey":"abc"}`))
+	assert.Nil(t, err, "Error should be nil.")
+
+	var dump *logEntry
+	for i := range logger.entries {
+		if logger.entries[i].level == "debug" {
+			dump = &logger.entries[i]
+		}
+	}
+	if assert.NotNil(t, dump, "Debug dump should be logged") {
+		headers := dump.attrs["requestHeaders"].(map[string]string)
+		assert.Equal(t, redacted, headers["Authorization"])
+		assert.Equal(t, `{"apiKey":"[REDACTED]","name":"Smith 1"}`, dump.attrs["requestBody"])
+		assert.Equal(t, `{"contacts":[{"password":"[REDACTED]"}],"id":9,"name":"Smith 1"}`, dump.attrs["responseBody"])
+	}
+}
+
+func TestLogger_Levels(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client, err := NewClient(SetHost(server.URL), SetLogger(logger))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Well.Get(1)
+	assert.True(t, IsNotFound(err))
+	if assert.Len(t, logger.entries, 1) {
+		assert.Equal(t, "warn", logger.entries[0].level)
+	}
+
+	server.Close()
+	logger.entries = nil
+	_, err = client.Well.Get(1)
+	assert.NotNil(t, err, "Error should not be nil.")
+	if assert.Len(t, logger.entries, 1) {
+		assert.Equal(t, "error", logger.entries[0].level)
+		assert.Contains(t, logger.entries[0].attrs, "error")
+	}
+}
+
+func TestRedactHeaders_CustomAuthHeader(t *testing.T) {
+
+	client, err := NewClient(SetAuthHeader("X-District-Token", "abc123"))
+	assert.Nil(t, err, "Error should be nil.")
+
+	masked := client.redactHeaders(http.Header{
+		"X-District-Token": []string{"abc123"},
+		"Accept":           []string{"application/json"},
+	})
+	assert.Equal(t, redacted, masked["X-District-Token"])
+	assert.Equal(t, "application/json", masked["Accept"])
+}
@@ -17,6 +17,12 @@ type MeterModel struct {
 	Wells           []WellModel `json:"wells"`
 }
 
+// meterDecommission patch decommissioning a meter
+type meterDecommission struct {
+	Active          bool      `json:"active"`
+	DecomissionDate time.Time `json:"decomissionDate"`
+}
+
 // Init Initalized spec and default backing functions for model instance
 func (model *MeterModel) Init(spec *ServiceSpec) *MeterModel {
 	model.Spec = spec
@@ -1,11 +1,10 @@
 package hydros
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"io/ioutil"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strconv"
 	"time"
@@ -26,24 +25,46 @@ func NewMeterReadingService(client *Client) MeterReadingService {
 type MeterReadingService interface {
 	Service
 	Get(wellID uint, meterID uint, ID uint) (*MeterReadingModel, error)
+	GetContext(ctx context.Context, wellID uint, meterID uint, ID uint) (*MeterReadingModel, error)
 	CountByWell(wellID uint) (int, error)
+	CountByWellContext(ctx context.Context, wellID uint) (int, error)
 	CountByWellAndMeter(wellID uint, meterID uint) (int, error)
+	CountByWellAndMeterContext(ctx context.Context, wellID uint, meterID uint) (int, error)
 	ListByWell(wellID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error)
+	ListByWellContext(ctx context.Context, wellID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error)
 	ListByWellAndMeter(wellID uint, meterID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error)
+	ListByWellAndMeterContext(ctx context.Context, wellID uint, meterID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error)
+	ListAllByWell(wellID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator
+	ListAllByWellContext(ctx context.Context, wellID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator
+	ListAllByWellAndMeter(wellID uint, meterID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator
+	ListAllByWellAndMeterContext(ctx context.Context, wellID uint, meterID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator
 	GetProductionByWell(wellID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) ([]ProductionModel, error)
+	GetProductionByWellContext(ctx context.Context, wellID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) ([]ProductionModel, error)
 	GetProductionByWellAndMeter(wellID uint, meterID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*ProductionModel, error)
+	GetProductionByWellAndMeterContext(ctx context.Context, wellID uint, meterID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*ProductionModel, error)
 }
 
 // DefaultMeterReadingService default meter reading service struct that contains backing functions
 type DefaultMeterReadingService struct {
 	*DefaultService
-	GetFunc                         func(wellID uint, meterID uint, ID uint) (*MeterReadingModel, error)
-	CountByWellFunc                 func(wellID uint) (int, error)
-	CountByWellAndMeterFunc         func(wellID uint, meterID uint) (int, error)
-	ListByWellFunc                  func(wellID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error)
-	ListByWellAndMeterFunc          func(wellID uint, meterID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error)
-	GetProductionByWellFunc         func(wellID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) ([]ProductionModel, error)
-	GetProductionByWellAndMeterFunc func(wellID uint, meterID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*ProductionModel, error)
+	GetFunc                                func(wellID uint, meterID uint, ID uint) (*MeterReadingModel, error)
+	GetContextFunc                         func(ctx context.Context, wellID uint, meterID uint, ID uint) (*MeterReadingModel, error)
+	CountByWellFunc                        func(wellID uint) (int, error)
+	CountByWellContextFunc                 func(ctx context.Context, wellID uint) (int, error)
+	CountByWellAndMeterFunc                func(wellID uint, meterID uint) (int, error)
+	CountByWellAndMeterContextFunc         func(ctx context.Context, wellID uint, meterID uint) (int, error)
+	ListByWellFunc                         func(wellID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error)
+	ListByWellContextFunc                  func(ctx context.Context, wellID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error)
+	ListByWellAndMeterFunc                 func(wellID uint, meterID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error)
+	ListByWellAndMeterContextFunc          func(ctx context.Context, wellID uint, meterID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error)
+	ListAllByWellFunc                      func(wellID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator
+	ListAllByWellContextFunc               func(ctx context.Context, wellID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator
+	ListAllByWellAndMeterFunc              func(wellID uint, meterID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator
+	ListAllByWellAndMeterContextFunc       func(ctx context.Context, wellID uint, meterID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator
+	GetProductionByWellFunc                func(wellID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) ([]ProductionModel, error)
+	GetProductionByWellContextFunc         func(ctx context.Context, wellID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) ([]ProductionModel, error)
+	GetProductionByWellAndMeterFunc        func(wellID uint, meterID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*ProductionModel, error)
+	GetProductionByWellAndMeterContextFunc func(ctx context.Context, wellID uint, meterID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*ProductionModel, error)
 }
 
 // Init initalized spec and default backing functions for service
@@ -52,34 +73,18 @@ func (service *DefaultMeterReadingService) Init(spec *ServiceSpec) *DefaultMeter
 
 	// Define Get backing function
 	service.GetFunc = func(wellID uint, meterID uint, ID uint) (*MeterReadingModel, error) {
-		uri := fmt.Sprintf("%s/wells/%d/meters/%d/readings/%d.json", service.Spec.Client.URL.String(), wellID, meterID, ID)
-		req, err := http.NewRequest("GET", uri, nil)
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
-
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
-
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp.StatusCode != 200 {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
-		}
+		return service.GetContextFunc(context.Background(), wellID, meterID, ID)
+	}
 
+	// Define GetContext backing function
+	service.GetContextFunc = func(ctx context.Context, wellID uint, meterID uint, ID uint) (*MeterReadingModel, error) {
 		var meterReading MeterReadingModel
-		err = json.Unmarshal(bodyBytes, &meterReading)
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("wells/%d/meters/%d/readings/%d.json", wellID, meterID, ID),
+			ExpectedStatus: http.StatusOK,
+			Result:         &meterReading,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -88,65 +93,85 @@ func (service *DefaultMeterReadingService) Init(spec *ServiceSpec) *DefaultMeter
 
 	// Define CountByWell backing function
 	service.CountByWellFunc = func(wellID uint) (int, error) {
-		return 0, errors.New("not implemented")
+		return service.CountByWellContextFunc(context.Background(), wellID)
+	}
+
+	// Define CountByWellContext backing function
+	service.CountByWellContextFunc = func(ctx context.Context, wellID uint) (int, error) {
+		return 0, ErrNotImplemented
 	}
 
 	// Define CountByWellAndMeter backing function
 	service.CountByWellAndMeterFunc = func(wellID uint, meterID uint) (int, error) {
-		return 0, errors.New("not implemented")
+		return service.CountByWellAndMeterContextFunc(context.Background(), wellID, meterID)
+	}
+
+	// Define CountByWellAndMeterContext backing function
+	service.CountByWellAndMeterContextFunc = func(ctx context.Context, wellID uint, meterID uint) (int, error) {
+		return 0, ErrNotImplemented
 	}
 
 	// Define ListByWell backing function
 	service.ListByWellFunc = func(wellID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error) {
-		return nil, errors.New("not implemented")
+		return service.ListByWellContextFunc(context.Background(), wellID, from, size, sort, startDate, endDate)
+	}
+
+	// Define ListByWellContext backing function
+	service.ListByWellContextFunc = func(ctx context.Context, wellID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error) {
+		return nil, ErrNotImplemented
 	}
 
 	// Define ListByWellAndMeter backing function
 	service.ListByWellAndMeterFunc = func(wellID uint, meterID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error) {
-		return nil, errors.New("not implemented")
+		return service.ListByWellAndMeterContextFunc(context.Background(), wellID, meterID, from, size, sort, startDate, endDate)
 	}
 
-	// Define GetProductionByWell backing function
-	service.GetProductionByWellFunc = func(wellID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) ([]ProductionModel, error) {
-		uri := fmt.Sprintf("%s/wells/%d/production.json", service.Spec.Client.URL.String(), wellID)
-		req, err := http.NewRequest("GET", uri, nil)
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
-
-		q := req.URL.Query()
-		if fromDate != nil {
-			q.Add("fromDate", fromDate.Format("2006-01-02"))
-		}
+	// Define ListByWellAndMeterContext backing function
+	service.ListByWellAndMeterContextFunc = func(ctx context.Context, wellID uint, meterID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error) {
+		return nil, ErrNotImplemented
+	}
 
-		if toDate != nil {
-			q.Add("toDate", toDate.Format("2006-01-02"))
-		}
+	// Define ListAllByWell backing function
+	service.ListAllByWellFunc = func(wellID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator {
+		return service.ListAllByWellContextFunc(context.Background(), wellID, sort, startDate, endDate, options...)
+	}
 
-		q.Add("estimateBounds", strconv.FormatBool(estimateBounds))
+	// Define ListAllByWellContext backing function
+	service.ListAllByWellContextFunc = func(ctx context.Context, wellID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator {
+		return &MeterReadingIterator{pager: newPager(ctx, 0,
+			readingPages(func(ctx context.Context, from int, size int) ([]MeterReadingModel, error) {
+				return service.ListByWellContextFunc(ctx, wellID, from, size, sort, startDate, endDate)
+			}), options)}
+	}
 
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
+	// Define ListAllByWellAndMeter backing function
+	service.ListAllByWellAndMeterFunc = func(wellID uint, meterID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator {
+		return service.ListAllByWellAndMeterContextFunc(context.Background(), wellID, meterID, sort, startDate, endDate, options...)
+	}
 
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
-		}
+	// Define ListAllByWellAndMeterContext backing function
+	service.ListAllByWellAndMeterContextFunc = func(ctx context.Context, wellID uint, meterID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator {
+		return &MeterReadingIterator{pager: newPager(ctx, 0,
+			readingPages(func(ctx context.Context, from int, size int) ([]MeterReadingModel, error) {
+				return service.ListByWellAndMeterContextFunc(ctx, wellID, meterID, from, size, sort, startDate, endDate)
+			}), options)}
+	}
 
-		if resp.StatusCode != 200 {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
-		}
+	// Define GetProductionByWell backing function
+	service.GetProductionByWellFunc = func(wellID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) ([]ProductionModel, error) {
+		return service.GetProductionByWellContextFunc(context.Background(), wellID, fromDate, toDate, estimateBounds)
+	}
 
+	// Define GetProductionByWellContext backing function
+	service.GetProductionByWellContextFunc = func(ctx context.Context, wellID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) ([]ProductionModel, error) {
 		var production []ProductionModel
-		err = json.Unmarshal(bodyBytes, &production)
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("wells/%d/production.json", wellID),
+			Query:          productionQuery(fromDate, toDate, estimateBounds),
+			ExpectedStatus: http.StatusOK,
+			Result:         &production,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -155,52 +180,54 @@ func (service *DefaultMeterReadingService) Init(spec *ServiceSpec) *DefaultMeter
 
 	// Define GetProductionByWellAndMeter backing function
 	service.GetProductionByWellAndMeterFunc = func(wellID uint, meterID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*ProductionModel, error) {
-		uri := fmt.Sprintf("%s/wells/%d/meters/%d/production.json", service.Spec.Client.URL.String(), wellID, meterID)
-		req, err := http.NewRequest("GET", uri, nil)
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
-
-		q := req.URL.Query()
-		if fromDate != nil {
-			q.Add("fromDate", fromDate.Format("2006-01-02"))
-		}
-
-		if toDate != nil {
-			q.Add("toDate", toDate.Format("2006-01-02"))
-		}
-
-		q.Add("estimateBounds", strconv.FormatBool(estimateBounds))
-
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
+		return service.GetProductionByWellAndMeterContextFunc(context.Background(), wellID, meterID, fromDate, toDate, estimateBounds)
+	}
 
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
+	// Define GetProductionByWellAndMeterContext backing function
+	service.GetProductionByWellAndMeterContextFunc = func(ctx context.Context, wellID uint, meterID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*ProductionModel, error) {
+		var production ProductionModel
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("wells/%d/meters/%d/production.json", wellID, meterID),
+			Query:          productionQuery(fromDate, toDate, estimateBounds),
+			ExpectedStatus: http.StatusOK,
+			Result:         &production,
+		})
 		if err != nil {
 			return nil, err
 		}
+		return &production, nil
+	}
 
-		if resp.StatusCode != 200 {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
-		}
+	return service
+}
 
-		var production ProductionModel
-		err = json.Unmarshal(bodyBytes, &production)
+// readingPages adapts a meter reading list func to a pageFetcher
+func readingPages(list func(ctx context.Context, from int, size int) ([]MeterReadingModel, error)) pageFetcher {
+	return func(ctx context.Context, from int, size int) ([]interface{}, int, error) {
+		readings, err := list(ctx, from, size)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
-		return &production, nil
+		items := make([]interface{}, len(readings))
+		for i := range readings {
+			items[i] = &readings[i]
+		}
+		return items, -1, nil
 	}
+}
 
-	return service
+// productionQuery builds query parameters shared by production endpoints
+func productionQuery(fromDate *time.Time, toDate *time.Time, estimateBounds bool) url.Values {
+	q := url.Values{}
+	if fromDate != nil {
+		q.Add("fromDate", fromDate.Format("2006-01-02"))
+	}
+	if toDate != nil {
+		q.Add("toDate", toDate.Format("2006-01-02"))
+	}
+	q.Add("estimateBounds", strconv.FormatBool(estimateBounds))
+	return q
 }
 
 // Get meter reading by id
@@ -208,32 +235,88 @@ func (service *DefaultMeterReadingService) Get(wellID uint, meterID uint, ID uin
 	return service.GetFunc(wellID, meterID, ID)
 }
 
-// Get meter reading count by well id
+// GetContext meter reading by id using the provided context
+func (service *DefaultMeterReadingService) GetContext(ctx context.Context, wellID uint, meterID uint, ID uint) (*MeterReadingModel, error) {
+	return service.GetContextFunc(ctx, wellID, meterID, ID)
+}
+
+// CountByWell Get meter reading count by well id
 func (service *DefaultMeterReadingService) CountByWell(wellID uint) (int, error) {
 	return service.CountByWellFunc(wellID)
 }
 
-// Get meter reading count by well id and meter id
+// CountByWellContext Get meter reading count by well id using the provided context
+func (service *DefaultMeterReadingService) CountByWellContext(ctx context.Context, wellID uint) (int, error) {
+	return service.CountByWellContextFunc(ctx, wellID)
+}
+
+// CountByWellAndMeter Get meter reading count by well id and meter id
 func (service *DefaultMeterReadingService) CountByWellAndMeter(wellID uint, meterID uint) (int, error) {
 	return service.CountByWellAndMeterFunc(wellID, meterID)
 }
 
-// Get meter readings by well id
+// CountByWellAndMeterContext Get meter reading count by well id and meter id using the provided context
+func (service *DefaultMeterReadingService) CountByWellAndMeterContext(ctx context.Context, wellID uint, meterID uint) (int, error) {
+	return service.CountByWellAndMeterContextFunc(ctx, wellID, meterID)
+}
+
+// ListByWell Get meter readings by well id
 func (service *DefaultMeterReadingService) ListByWell(wellID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error) {
 	return service.ListByWellFunc(wellID, from, size, sort, startDate, endDate)
 }
 
-// Get meter readings by well id and meter id
+// ListByWellContext Get meter readings by well id using the provided context
+func (service *DefaultMeterReadingService) ListByWellContext(ctx context.Context, wellID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error) {
+	return service.ListByWellContextFunc(ctx, wellID, from, size, sort, startDate, endDate)
+}
+
+// ListByWellAndMeter Get meter readings by well id and meter id
 func (service *DefaultMeterReadingService) ListByWellAndMeter(wellID uint, meterID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error) {
 	return service.ListByWellAndMeterFunc(wellID, meterID, from, size, sort, startDate, endDate)
 }
 
-// Get production by well id
+// ListByWellAndMeterContext Get meter readings by well id and meter id using the provided context
+func (service *DefaultMeterReadingService) ListByWellAndMeterContext(ctx context.Context, wellID uint, meterID uint, from int, size int, sort []Sort, startDate *time.Time, endDate *time.Time) ([]MeterReadingModel, error) {
+	return service.ListByWellAndMeterContextFunc(ctx, wellID, meterID, from, size, sort, startDate, endDate)
+}
+
+// ListAllByWell iterates over every meter reading of a well, fetching pages lazily through ListByWellContextFunc
+func (service *DefaultMeterReadingService) ListAllByWell(wellID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator {
+	return service.ListAllByWellFunc(wellID, sort, startDate, endDate, options...)
+}
+
+// ListAllByWellContext iterates over every meter reading of a well using the provided context
+func (service *DefaultMeterReadingService) ListAllByWellContext(ctx context.Context, wellID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator {
+	return service.ListAllByWellContextFunc(ctx, wellID, sort, startDate, endDate, options...)
+}
+
+// ListAllByWellAndMeter iterates over every reading of a well meter, fetching pages lazily through
+// ListByWellAndMeterContextFunc
+func (service *DefaultMeterReadingService) ListAllByWellAndMeter(wellID uint, meterID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator {
+	return service.ListAllByWellAndMeterFunc(wellID, meterID, sort, startDate, endDate, options...)
+}
+
+// ListAllByWellAndMeterContext iterates over every reading of a well meter using the provided context
+func (service *DefaultMeterReadingService) ListAllByWellAndMeterContext(ctx context.Context, wellID uint, meterID uint, sort []Sort, startDate *time.Time, endDate *time.Time, options ...IteratorOptionFunc) *MeterReadingIterator {
+	return service.ListAllByWellAndMeterContextFunc(ctx, wellID, meterID, sort, startDate, endDate, options...)
+}
+
+// GetProductionByWell Get production by well id
 func (service *DefaultMeterReadingService) GetProductionByWell(wellID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) ([]ProductionModel, error) {
 	return service.GetProductionByWellFunc(wellID, fromDate, toDate, estimateBounds)
 }
 
-// Get production by well id and meter id
+// GetProductionByWellContext Get production by well id using the provided context
+func (service *DefaultMeterReadingService) GetProductionByWellContext(ctx context.Context, wellID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) ([]ProductionModel, error) {
+	return service.GetProductionByWellContextFunc(ctx, wellID, fromDate, toDate, estimateBounds)
+}
+
+// GetProductionByWellAndMeter Get production by well id and meter id
 func (service *DefaultMeterReadingService) GetProductionByWellAndMeter(wellID uint, meterID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*ProductionModel, error) {
 	return service.GetProductionByWellAndMeterFunc(wellID, meterID, fromDate, toDate, estimateBounds)
 }
+
+// GetProductionByWellAndMeterContext Get production by well id and meter id using the provided context
+func (service *DefaultMeterReadingService) GetProductionByWellAndMeterContext(ctx context.Context, wellID uint, meterID uint, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*ProductionModel, error) {
+	return service.GetProductionByWellAndMeterContextFunc(ctx, wellID, meterID, fromDate, toDate, estimateBounds)
+}
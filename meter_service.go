@@ -1,10 +1,9 @@
 package hydros
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"reflect"
 	"time"
@@ -26,20 +25,30 @@ type MeterService interface {
 	Service
 
 	Get(wellID uint, ID uint) (*MeterModel, error)
+	GetContext(ctx context.Context, wellID uint, ID uint) (*MeterModel, error)
 	ListByWellID(wellID uint) ([]MeterModel, error)
-	Create(model *MeterModel) (*MeterModel, error)
-	Update(model *MeterModel) (*MeterModel, error)
-	Decommission(id uint, decommissionTime time.Time) (*MeterModel, error)
+	ListByWellIDContext(ctx context.Context, wellID uint) ([]MeterModel, error)
+	Create(wellID uint, model *MeterModel) (*MeterModel, error)
+	CreateContext(ctx context.Context, wellID uint, model *MeterModel) (*MeterModel, error)
+	Update(wellID uint, model *MeterModel) (*MeterModel, error)
+	UpdateContext(ctx context.Context, wellID uint, model *MeterModel) (*MeterModel, error)
+	Decommission(wellID uint, id uint, decommissionTime time.Time) (*MeterModel, error)
+	DecommissionContext(ctx context.Context, wellID uint, id uint, decommissionTime time.Time) (*MeterModel, error)
 }
 
 // DefaultMeterService default meter service struct that contains backing functions
 type DefaultMeterService struct {
 	*DefaultService
-	GetFunc          func(wellID uint, ID uint) (*MeterModel, error)
-	ListByWellIDFunc func(wellID uint) ([]MeterModel, error)
-	CreateFunc       func(model *MeterModel) (*MeterModel, error)
-	UpdateFunc       func(model *MeterModel) (*MeterModel, error)
-	DecommissionFunc func(id uint, decommissionTime time.Time) (*MeterModel, error)
+	GetFunc                 func(wellID uint, ID uint) (*MeterModel, error)
+	GetContextFunc          func(ctx context.Context, wellID uint, ID uint) (*MeterModel, error)
+	ListByWellIDFunc        func(wellID uint) ([]MeterModel, error)
+	ListByWellIDContextFunc func(ctx context.Context, wellID uint) ([]MeterModel, error)
+	CreateFunc              func(wellID uint, model *MeterModel) (*MeterModel, error)
+	CreateContextFunc       func(ctx context.Context, wellID uint, model *MeterModel) (*MeterModel, error)
+	UpdateFunc              func(wellID uint, model *MeterModel) (*MeterModel, error)
+	UpdateContextFunc       func(ctx context.Context, wellID uint, model *MeterModel) (*MeterModel, error)
+	DecommissionFunc        func(wellID uint, id uint, decommissionTime time.Time) (*MeterModel, error)
+	DecommissionContextFunc func(ctx context.Context, wellID uint, id uint, decommissionTime time.Time) (*MeterModel, error)
 }
 
 // Init initialized spec and default backing functions for service
@@ -48,89 +57,129 @@ func (service *DefaultMeterService) Init(spec *ServiceSpec) *DefaultMeterService
 
 	// Define Get backing function
 	service.GetFunc = func(wellID uint, ID uint) (*MeterModel, error) {
-		uri := fmt.Sprintf("%s/wells/%d/%s/%d.json", service.Spec.Client.URL.String(), wellID, service.Spec.ServiceName, ID)
-		req, err := http.NewRequest("GET", uri, nil)
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
+		return service.GetContextFunc(context.Background(), wellID, ID)
+	}
 
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
+	// Define GetContext backing function
+	service.GetContextFunc = func(ctx context.Context, wellID uint, ID uint) (*MeterModel, error) {
+		var meter MeterModel
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("wells/%d/%s/%d.json", wellID, service.Spec.ServiceName, ID),
+			ExpectedStatus: http.StatusOK,
+			Result:         &meter,
+		})
 		if err != nil {
 			return nil, err
 		}
+		return meter.Init(service.Spec), nil
+	}
 
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
+	// Define ListByWellID backing function
+	service.ListByWellIDFunc = func(wellID uint) ([]MeterModel, error) {
+		return service.ListByWellIDContextFunc(context.Background(), wellID)
+	}
+
+	// Define ListByWellIDContext backing function
+	service.ListByWellIDContextFunc = func(ctx context.Context, wellID uint) ([]MeterModel, error) {
+		var meters []MeterModel
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("wells/%d/meters.json", wellID),
+			ExpectedStatus: http.StatusOK,
+			Result:         &meters,
+		})
 		if err != nil {
 			return nil, err
 		}
+		for i := range meters {
+			meters[i].Init(service.Spec)
+		}
+		return meters, nil
+	}
 
-		if resp.StatusCode != 200 {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
+	// Define Create backing function
+	service.CreateFunc = func(wellID uint, model *MeterModel) (*MeterModel, error) {
+		return service.CreateContextFunc(context.Background(), wellID, model)
+	}
+
+	// Define CreateContext backing function
+	service.CreateContextFunc = func(ctx context.Context, wellID uint, model *MeterModel) (*MeterModel, error) {
+		if model == nil {
+			return nil, errors.New("meter model must not be nil")
 		}
 
 		var meter MeterModel
-		err = json.Unmarshal(bodyBytes, &meter)
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodPost,
+			Path:           fmt.Sprintf("wells/%d/%s.json", wellID, service.Spec.ServiceName),
+			Body:           model,
+			ExpectedStatus: http.StatusCreated,
+			Result:         &meter,
+		})
 		if err != nil {
 			return nil, err
 		}
+		if meter.DefaultModelBase == nil {
+			return nil, ErrEmptyResponse
+		}
 		return meter.Init(service.Spec), nil
 	}
 
-	// Define ListByWellID backing function
-	service.ListByWellIDFunc = func(wellID uint) ([]MeterModel, error) {
-		uri := fmt.Sprintf("%s/wells/%d/meters.json", service.Spec.Client.URL.String(), wellID)
-		req, err := http.NewRequest("GET", uri, nil)
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
+	// Define Update backing function
+	service.UpdateFunc = func(wellID uint, model *MeterModel) (*MeterModel, error) {
+		return service.UpdateContextFunc(context.Background(), wellID, model)
+	}
 
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
-		if err != nil {
-			return nil, err
+	// Define UpdateContext backing function
+	service.UpdateContextFunc = func(ctx context.Context, wellID uint, model *MeterModel) (*MeterModel, error) {
+		if model == nil || model.DefaultModelBase == nil || model.ID == 0 {
+			return nil, errors.New("meter model must have an ID to be updated")
 		}
 
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
+		var meter MeterModel
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodPut,
+			Path:           fmt.Sprintf("wells/%d/%s/%d.json", wellID, service.Spec.ServiceName, model.ID),
+			Body:           model,
+			ExpectedStatus: http.StatusOK,
+			Result:         &meter,
+		})
 		if err != nil {
 			return nil, err
 		}
-
-		if resp.StatusCode != 200 {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
-		}
-
-		var meters []MeterModel
-		err = json.Unmarshal(bodyBytes, &meters)
-		if err != nil {
-			return nil, err
+		if meter.DefaultModelBase == nil {
+			return nil, ErrEmptyResponse
 		}
-		return meters, nil
-	}
-
-	// Define Create backing function
-	service.CreateFunc = func(model *MeterModel) (*MeterModel, error) {
-		return nil, errors.New("not implemented")
+		return meter.Init(service.Spec), nil
 	}
 
-	// Define Update backing function
-	service.UpdateFunc = func(model *MeterModel) (*MeterModel, error) {
-		return nil, errors.New("not implemented")
+	// Define Decommission backing function
+	service.DecommissionFunc = func(wellID uint, id uint, decommissionTime time.Time) (*MeterModel, error) {
+		return service.DecommissionContextFunc(context.Background(), wellID, id, decommissionTime)
 	}
 
-	// Define Decommission backing function
-	service.DecommissionFunc = func(id uint, decommissionTime time.Time) (*MeterModel, error) {
-		return nil, errors.New("not implemented")
+	// Define DecommissionContext backing function
+	service.DecommissionContextFunc = func(ctx context.Context, wellID uint, id uint, decommissionTime time.Time) (*MeterModel, error) {
+		var meter MeterModel
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method: http.MethodPatch,
+			Path:   fmt.Sprintf("wells/%d/%s/%d.json", wellID, service.Spec.ServiceName, id),
+			Body: &meterDecommission{
+				Active:          false,
+				DecomissionDate: decommissionTime,
+			},
+			ExpectedStatus: http.StatusAccepted,
+			Result:         &meter,
+		})
+		// Accepted responses may have no body, fetch the decommissioned meter instead
+		if err == ErrEmptyResponse || (err == nil && meter.DefaultModelBase == nil) {
+			return service.GetContextFunc(ctx, wellID, id)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return meter.Init(service.Spec), nil
 	}
 
 	return service
@@ -141,22 +190,47 @@ func (service *DefaultMeterService) Get(wellID uint, ID uint) (*MeterModel, erro
 	return service.GetFunc(wellID, ID)
 }
 
-// List Get list of meters for well
+// GetContext Get payload object by id using the provided context
+func (service *DefaultMeterService) GetContext(ctx context.Context, wellID uint, ID uint) (*MeterModel, error) {
+	return service.GetContextFunc(ctx, wellID, ID)
+}
+
+// ListByWellID Get list of meters for well
 func (service *DefaultMeterService) ListByWellID(wellID uint) ([]MeterModel, error) {
 	return service.ListByWellIDFunc(wellID)
 }
 
-// Create Create new
-func (service *DefaultMeterService) Create(model *MeterModel) (*MeterModel, error) {
-	return service.CreateFunc(model)
+// ListByWellIDContext Get list of meters for well using the provided context
+func (service *DefaultMeterService) ListByWellIDContext(ctx context.Context, wellID uint) ([]MeterModel, error) {
+	return service.ListByWellIDContextFunc(ctx, wellID)
+}
+
+// Create Create new meter on well
+func (service *DefaultMeterService) Create(wellID uint, model *MeterModel) (*MeterModel, error) {
+	return service.CreateFunc(wellID, model)
+}
+
+// CreateContext Create new meter on well using the provided context
+func (service *DefaultMeterService) CreateContext(ctx context.Context, wellID uint, model *MeterModel) (*MeterModel, error) {
+	return service.CreateContextFunc(ctx, wellID, model)
+}
+
+// Update Update meter of well
+func (service *DefaultMeterService) Update(wellID uint, model *MeterModel) (*MeterModel, error) {
+	return service.UpdateFunc(wellID, model)
+}
+
+// UpdateContext Update meter of well using the provided context
+func (service *DefaultMeterService) UpdateContext(ctx context.Context, wellID uint, model *MeterModel) (*MeterModel, error) {
+	return service.UpdateContextFunc(ctx, wellID, model)
 }
 
-// Update Update model
-func (service *DefaultMeterService) Update(model *MeterModel) (*MeterModel, error) {
-	return service.UpdateFunc(model)
+// Decommission Decommission meter of well, setting its decommission date and marking it inactive
+func (service *DefaultMeterService) Decommission(wellID uint, id uint, decommissionDate time.Time) (*MeterModel, error) {
+	return service.DecommissionFunc(wellID, id, decommissionDate)
 }
 
-// Decommission Decommission model
-func (service *DefaultMeterService) Decommission(id uint, decommissionDate time.Time) (*MeterModel, error) {
-	return service.DecommissionFunc(id, decommissionDate)
+// DecommissionContext Decommission meter of well using the provided context
+func (service *DefaultMeterService) DecommissionContext(ctx context.Context, wellID uint, id uint, decommissionDate time.Time) (*MeterModel, error) {
+	return service.DecommissionContextFunc(ctx, wellID, id, decommissionDate)
 }
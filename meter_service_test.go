@@ -2,6 +2,9 @@ package hydros
 
 import (
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
@@ -30,10 +33,10 @@ func TestDefaultMeterServiceCreateFunc(t *testing.T) {
 			PayloadModelType: reflect.TypeOf(MeterModel{}),
 		})
 
-	defaultMeterService.CreateFunc = func(model *MeterModel) (*MeterModel, error) {
+	defaultMeterService.CreateFunc = func(wellID uint, model *MeterModel) (*MeterModel, error) {
 		return model, nil
 	}
-	returnedModel, err := defaultMeterService.Create(&MeterModel{DefaultModelBase: &DefaultModelBase{ID: 3332}})
+	returnedModel, err := defaultMeterService.Create(1, &MeterModel{DefaultModelBase: &DefaultModelBase{ID: 3332}})
 	assert.Nil(t, err, "Error should be nil.")
 	assert.Equal(t, uint(3332), returnedModel.ID)
 }
@@ -81,10 +84,10 @@ func TestDefaultMeterServiceUpdateFunc(t *testing.T) {
 			PayloadModelType: reflect.TypeOf(MeterModel{}),
 		})
 
-	defaultMeterService.UpdateFunc = func(model *MeterModel) (*MeterModel, error) {
+	defaultMeterService.UpdateFunc = func(wellID uint, model *MeterModel) (*MeterModel, error) {
 		return model, nil
 	}
-	returnedModel, err := defaultMeterService.Update(&MeterModel{DefaultModelBase: &DefaultModelBase{ID: 2}})
+	returnedModel, err := defaultMeterService.Update(1, &MeterModel{DefaultModelBase: &DefaultModelBase{ID: 2}})
 	assert.Nil(t, err, "Error should be nil.")
 	assert.Equal(t, uint(2), returnedModel.ID)
 }
@@ -97,15 +100,104 @@ func TestDefaultMeterServiceDecommissionFunc(t *testing.T) {
 			PayloadModelType: reflect.TypeOf(MeterModel{}),
 		})
 
-	defaultMeterService.DecommissionFunc = func(id uint, decommissionDate time.Time) (*MeterModel, error) {
+	defaultMeterService.DecommissionFunc = func(wellID uint, id uint, decommissionDate time.Time) (*MeterModel, error) {
 		model := MeterModel{DefaultModelBase: &DefaultModelBase{ID: id}, DecomissionDate: &decommissionDate}
 		return &model, nil
 	}
 
 	decomTime := time.Now()
 
-	returnedModel, err := defaultMeterService.Decommission(2, decomTime)
+	returnedModel, err := defaultMeterService.Decommission(1, 2, decomTime)
 	assert.Nil(t, err, "Error should be nil.")
 	assert.Equal(t, uint(2), returnedModel.ID)
 	assert.Equal(t, &decomTime, returnedModel.DecomissionDate)
 }
+
+func TestDefaultMeterService_Requests(t *testing.T) {
+
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /wells/7/meters.json":
+			_, _ = w.Write([]byte(`[{"id":3,"name":"North"},{"id":4,"name":"South"}]`))
+		case "POST /wells/7/meters.json":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":5,"name":"East","active":true}`))
+		case "PUT /wells/7/meters/5.json":
+			_, _ = w.Write([]byte(`{"id":5,"name":"East 2","active":true}`))
+		case "PATCH /wells/7/meters/5.json":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"id":5,"name":"East 2","active":false,"decomissionDate":"2020-05-01T00:00:00Z"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+	spec := client.Meter._ServiceSpec()
+
+	meters, err := client.Meter.ListByWellID(7)
+	assert.Nil(t, err, "Error should be nil.")
+	if assert.Len(t, meters, 2) {
+		assert.Equal(t, spec, meters[1].Spec)
+	}
+
+	created, err := client.Meter.Create(7, &MeterModel{Name: "East"})
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, uint(5), created.ID)
+	assert.Equal(t, spec, created.Spec)
+	assert.Contains(t, string(body), `"name":"East"`)
+
+	created.Name = "East 2"
+	updated, err := client.Meter.Update(7, created)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, "East 2", updated.Name)
+	_, err = client.Meter.Update(7, &MeterModel{Name: "New"})
+	assert.NotNil(t, err, "Error should not be nil.")
+
+	decommissioned, err := client.Meter.Decommission(7, 5, time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err, "Error should be nil.")
+	assert.JSONEq(t, `{"active":false,"decomissionDate":"2020-05-01T00:00:00Z"}`, string(body))
+	assert.False(t, decommissioned.Active)
+	assert.NotNil(t, decommissioned.DecomissionDate)
+	assert.Equal(t, spec, decommissioned.Spec)
+}
+
+func TestDefaultMeterService_EmptyResponses(t *testing.T) {
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"id":5,"name":"East","active":false,"decomissionDate":"2020-05-01T00:00:00Z"}`))
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodPut:
+			_, _ = w.Write([]byte(`{}`))
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Meter.Create(7, &MeterModel{Name: "East"})
+	assert.Equal(t, ErrEmptyResponse, err)
+	_, err = client.Meter.Update(7, &MeterModel{DefaultModelBase: &DefaultModelBase{ID: 5}, Name: "East"})
+	assert.Equal(t, ErrEmptyResponse, err)
+
+	// A bodiless 202 is followed by fetching the decommissioned meter
+	requests = nil
+	decommissioned, err := client.Meter.Decommission(7, 5, time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, []string{"PATCH /wells/7/meters/5.json", "GET /wells/7/meters/5.json"}, requests)
+	assert.Equal(t, uint(5), decommissioned.ID)
+	assert.False(t, decommissioned.Active)
+	assert.Equal(t, client.Meter._ServiceSpec(), decommissioned.Spec)
+}
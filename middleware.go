@@ -0,0 +1,105 @@
+package hydros
+
+import (
+	"errors"
+	"github.com/satori/go.uuid"
+	"net/http"
+	"time"
+)
+
+// Doer sends an HTTP request and returns its response.  *http.Client implements Doer
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapter allowing ordinary functions to be used as a Doer
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req)
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer sending every request made by services and models.  Middleware runs once per attempt,
+// so retried requests pass through the chain again
+type Middleware func(next Doer) Doer
+
+// AddMiddleware appends middleware to the client's chain.  The first middleware added is the outermost
+func AddMiddleware(middleware ...Middleware) ClientOptionFunc {
+	return func(c *Client) error {
+		for _, m := range middleware {
+			if m == nil {
+				return errors.New("middleware must not be nil")
+			}
+		}
+		c.Middleware = append(c.Middleware, middleware...)
+		return nil
+	}
+}
+
+// doer builds the middleware chain around the client's HTTP client
+func (client *Client) doer() Doer {
+	var doer Doer = &client.HTTPClient
+	for i := len(client.Middleware) - 1; i >= 0; i-- {
+		doer = client.Middleware[i](doer)
+	}
+	return doer
+}
+
+// HeaderMiddleware sets the given headers on every request
+func HeaderMiddleware(headers ...RequestHeader) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			for _, header := range headers {
+				req.Header.Set(header.Key, header.Value)
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+// CorrelationIDMiddleware sets header to a new ID on every request that does not already carry one.  A nil
+// generate func creates random UUIDs
+func CorrelationIDMiddleware(header string, generate func() string) Middleware {
+	if generate == nil {
+		generate = func() string {
+			return uuid.NewV4().String()
+		}
+	}
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(header) == "" {
+				req.Header.Set(header, generate())
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+// Printfer logger accepted by LoggingMiddleware.  *log.Logger implements Printfer
+type Printfer interface {
+	Printf(format string, v ...interface{})
+}
+
+// LoggingMiddleware logs method, URL, status and duration of every request for auditing
+func LoggingMiddleware(logger Printfer) Middleware {
+	return TimingMiddleware(func(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+		if err != nil {
+			logger.Printf("hydros: %s %s failed after %s: %v", req.Method, req.URL.Redacted(), duration, err)
+			return
+		}
+		logger.Printf("hydros: %s %s %d (%s)", req.Method, req.URL.Redacted(), resp.StatusCode, duration)
+	})
+}
+
+// TimingMiddleware calls observe with the outcome and duration of every request, e.g. to record metrics
+func TimingMiddleware(observe func(req *http.Request, resp *http.Response, err error, duration time.Duration)) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.Do(req)
+			observe(req, resp, err, time.Since(start))
+			return resp, err
+		})
+	}
+}
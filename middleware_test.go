@@ -0,0 +1,133 @@
+package hydros
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAddMiddleware_Order(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "outer,inner", r.Header.Get("X-Chain"))
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	var calls []string
+	tracing := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				chain := name
+				if existing := req.Header.Get("X-Chain"); existing != "" {
+					chain = existing + "," + name
+				}
+				req.Header.Set("X-Chain", chain)
+				return next.Do(req)
+			})
+		}
+	}
+
+	client, err := NewClient(SetHost(server.URL), AddMiddleware(tracing("outer")), AddMiddleware(tracing("inner")))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Well.Get(1)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, []string{"outer", "inner"}, calls)
+
+	// Model methods go through the chain as well
+	calls = nil
+	well := (&WellModel{DefaultModelBase: &DefaultModelBase{ID: 1}}).Init(client.Well._ServiceSpec())
+	_, _ = well.Permits()
+	assert.Equal(t, []string{"outer", "inner"}, calls)
+}
+
+func TestHeaderAndCorrelationIDMiddleware(t *testing.T) {
+
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		SetHost(server.URL),
+		AddMiddleware(
+			HeaderMiddleware(RequestHeader{Key: "X-District", Value: "north-plains"}),
+			CorrelationIDMiddleware("X-Correlation-Id", nil)))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Driller.Get(1)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, "north-plains", received.Get("X-District"))
+	assert.Len(t, received.Get("X-Correlation-Id"), 36)
+
+	client, err = NewClient(
+		SetHost(server.URL),
+		AddMiddleware(CorrelationIDMiddleware("X-Correlation-Id", func() string { return "fixed" })))
+	assert.Nil(t, err, "Error should be nil.")
+	_, err = client.Driller.Get(1)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, "fixed", received.Get("X-Correlation-Id"))
+}
+
+func TestLoggingAndTimingMiddleware(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	var buffer bytes.Buffer
+	var observed time.Duration
+	var status int
+	client, err := NewClient(
+		SetHost(server.URL),
+		AddMiddleware(
+			LoggingMiddleware(log.New(&buffer, "", 0)),
+			TimingMiddleware(func(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+				observed = duration
+				status = resp.StatusCode
+			})))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Well.Get(3)
+	assert.True(t, IsNotFound(err))
+	assert.True(t, observed > 0)
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.True(t, strings.HasPrefix(buffer.String(), "hydros: GET "+server.URL+"/wells/3.json 404 ("), buffer.String())
+}
+
+func TestAddMiddleware_Nil(t *testing.T) {
+
+	client, err := NewClient(AddMiddleware(nil))
+	assert.Nil(t, client, "Client should be nil")
+	assert.NotNil(t, err, "Error should not be nil.")
+}
+
+func TestAddMiddleware_StubResponse(t *testing.T) {
+
+	stub := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{}}, nil
+		})
+	}
+	client, err := NewClient(SetHost("https://example.com"), AddMiddleware(stub))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Well.Get(3)
+	assert.True(t, IsNotFound(err))
+	var apiError *APIError
+	if assert.True(t, errors.As(err, &apiError)) {
+		assert.Equal(t, http.MethodGet, apiError.Method)
+		assert.Equal(t, "https://example.com/wells/3.json", apiError.URL)
+	}
+}
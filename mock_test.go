@@ -1,6 +1,7 @@
 package hydros
 
 import (
+	"context"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/guregu/null.v3"
 	"testing"
@@ -127,3 +128,50 @@ func TestMockModelServiceMethod_WithErrors(t *testing.T) {
 	// TODO Need to test for wrong types
 
 }
+
+func TestMockServiceMethod_ContextVariant(t *testing.T) {
+
+	client, err := NewClient(SetHost("https://api.somewhere.com"))
+	assert.Nil(t, err, "Error should be nil.")
+
+	err = MockServiceMethod(
+		client,
+		"Well.GetContext",
+		func(ctx context.Context, ID uint) (*WellModel, error) {
+			return &WellModel{DefaultModelBase: &DefaultModelBase{ID: ID}}, nil
+		})
+	assert.Nil(t, err, "Error should be nil.")
+
+	well, err := client.Well.GetContext(context.Background(), 100)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, uint(100), well.ID, "Should have returned well with same ID")
+
+	// Mocking the context variant also covers the non-context method
+	well, err = client.Well.Get(101)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, uint(101), well.ID, "Should have returned well with same ID")
+}
+
+func TestMockModelServiceMethod_ContextVariant(t *testing.T) {
+	client, err := NewClient(SetHost("https://api.somewhere.com"))
+	assert.Nil(t, err, "Error should be nil.")
+
+	err = MockModelServiceMethod(
+		client.Well,
+		"UpdateContext",
+		func(model *WellModel, ctx context.Context, JSONMergePatch []byte) (*WellModel, error) {
+			model.Name = null.StringFrom(string(JSONMergePatch))
+			return model, nil
+		})
+	assert.Nil(t, err, "Error should be nil.")
+
+	well := (&WellModel{DefaultModelBase: &DefaultModelBase{ID: 1}}).Init(client.Well._ServiceSpec())
+
+	updatedWell, err := well.UpdateContext(context.Background(), []byte("patch"))
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, "patch", updatedWell.Name.String)
+
+	updatedWell, err = well.Update([]byte("other patch"))
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, "other patch", updatedWell.Name.String)
+}
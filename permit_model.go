@@ -1,10 +1,9 @@
 package hydros
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"gopkg.in/guregu/null.v3"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"time"
@@ -37,7 +36,8 @@ type PermitModel struct {
 	OperatorState          null.String         `json:"operatorState"`
 	OperatorPostalCode     null.String         `json:"operatorPostalCode"`
 
-	_Metrics func(model *PermitModel, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*PermitMetricsModel, error)
+	_Metrics        func(model *PermitModel, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*PermitMetricsModel, error)
+	_MetricsContext func(model *PermitModel, ctx context.Context, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*PermitMetricsModel, error)
 }
 
 // PermitTemplateModel PermitTemplate response payload
@@ -60,51 +60,31 @@ func (model *PermitModel) Init(spec *ServiceSpec) *PermitModel {
 		model._Metrics = serviceMock.MockFunc.(func(model *PermitModel, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*PermitMetricsModel, error))
 	} else {
 		model._Metrics = func(model *PermitModel, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*PermitMetricsModel, error) {
+			return model._MetricsContext(model, context.Background(), fromDate, toDate, estimateBounds)
+		}
+	}
 
-			uri := fmt.Sprintf("%s/%s/%d/metrics.json",
-				model.Spec.Client.URL.String(), model.Spec.ServiceName, model.ID)
-
-			baseURL, _ := url.Parse(uri)
+	if serviceMock, ok := spec.ModelServiceCallMocks["MetricsContext"]; ok {
+		model._MetricsContext = serviceMock.MockFunc.(func(model *PermitModel, ctx context.Context, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*PermitMetricsModel, error))
+	} else {
+		model._MetricsContext = func(model *PermitModel, ctx context.Context, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*PermitMetricsModel, error) {
 			params := url.Values{}
-
 			if fromDate != nil {
 				params.Add("fromDate", fromDate.Format("2006-01-02T15:04:05-0700"))
 			}
 			if toDate != nil {
-				params.Add("toDate", fromDate.Format("2006-01-02T15:04:05-0700"))
+				params.Add("toDate", toDate.Format("2006-01-02T15:04:05-0700"))
 			}
 			params.Add("estimateBounds", fmt.Sprint(estimateBounds))
 
-			baseURL.RawQuery = params.Encode()
-
-			req, err := http.NewRequest("GET", baseURL.String(), nil)
-
-			headers := model.Spec.Client.CreateHeadersFunc()
-			for h := 0; h < len(headers); h++ {
-				req.Header.Add(headers[h].Key, headers[h].Value)
-			}
-
-			resp, err := model.Spec.Client.HTTPClient.Do(req)
-			if err != nil {
-				return nil, err
-			}
-
-			bodyBytes, err := ioutil.ReadAll(resp.Body)
-			if err != nil {
-				return nil, err
-			}
-
-			if resp.StatusCode != 200 {
-				var errorResponse ErrorResponse
-				err = json.Unmarshal(bodyBytes, &errorResponse)
-				if err == nil && errorResponse.Message != "" {
-					return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-				}
-				return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
-			}
-
 			var metrics PermitMetricsModel
-			err = json.Unmarshal(bodyBytes, &metrics)
+			err := model.Spec.Client.execute(ctx, &apiRequest{
+				Method:         http.MethodGet,
+				Path:           fmt.Sprintf("%s/%d/metrics.json", model.Spec.ServiceName, model.ID),
+				Query:          params,
+				ExpectedStatus: http.StatusOK,
+				Result:         &metrics,
+			})
 			if err != nil {
 				return nil, err
 			}
@@ -125,6 +105,11 @@ func (model *PermitModel) Metrics(fromDate *time.Time, toDate *time.Time, estima
 	return model._Metrics(model, fromDate, toDate, estimateBounds)
 }
 
+// MetricsContext get permit metrics using the provided context
+func (model *PermitModel) MetricsContext(ctx context.Context, fromDate *time.Time, toDate *time.Time, estimateBounds bool) (*PermitMetricsModel, error) {
+	return model._MetricsContext(model, ctx, fromDate, toDate, estimateBounds)
+}
+
 type AmendWellPermitsRequest struct {
 	HistoryUpdateID string `json:"historyUpdateId"`
 	Patch           string `json:"patch"`
@@ -1,11 +1,8 @@
 package hydros
 
 import (
-	"bytes"
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"reflect"
 )
@@ -25,18 +22,26 @@ func NewPermitService(client *Client) PermitService {
 type PermitService interface {
 	Service
 	Get(ID uint) (*PermitModel, error)
+	GetContext(ctx context.Context, ID uint) (*PermitModel, error)
 	Count() (int, error)
+	CountContext(ctx context.Context) (int, error)
 	List(from int, size int, sort []Sort, ids []uint, aggregate bool) ([]*PermitModel, error)
+	ListContext(ctx context.Context, from int, size int, sort []Sort, ids []uint, aggregate bool) ([]*PermitModel, error)
 	AmendWellPermits(wellID uint, amendWellPermitsRequest AmendWellPermitsRequest) ([]PermitModel, error)
+	AmendWellPermitsContext(ctx context.Context, wellID uint, amendWellPermitsRequest AmendWellPermitsRequest) ([]PermitModel, error)
 }
 
 // DefaultPermitService default permit service struct that contains backing functions
 type DefaultPermitService struct {
 	*DefaultService
-	GetFunc              func(ID uint) (*PermitModel, error)
-	CountFunc            func() (int, error)
-	ListFunc             func(from int, size int, sort []Sort, ids []uint, aggregate bool) ([]*PermitModel, error)
-	AmendWellPermitsFunc func(wellID uint, amendWellPermitsRequest AmendWellPermitsRequest) ([]PermitModel, error)
+	GetFunc                     func(ID uint) (*PermitModel, error)
+	GetContextFunc              func(ctx context.Context, ID uint) (*PermitModel, error)
+	CountFunc                   func() (int, error)
+	CountContextFunc            func(ctx context.Context) (int, error)
+	ListFunc                    func(from int, size int, sort []Sort, ids []uint, aggregate bool) ([]*PermitModel, error)
+	ListContextFunc             func(ctx context.Context, from int, size int, sort []Sort, ids []uint, aggregate bool) ([]*PermitModel, error)
+	AmendWellPermitsFunc        func(wellID uint, amendWellPermitsRequest AmendWellPermitsRequest) ([]PermitModel, error)
+	AmendWellPermitsContextFunc func(ctx context.Context, wellID uint, amendWellPermitsRequest AmendWellPermitsRequest) ([]PermitModel, error)
 }
 
 // Init initialized spec and default backing functions for service
@@ -45,53 +50,49 @@ func (service *DefaultPermitService) Init(spec *ServiceSpec) *DefaultPermitServi
 
 	// Define Get backing function
 	service.GetFunc = func(ID uint) (*PermitModel, error) {
-		return nil, errors.New("not implemented")
+		return service.GetContextFunc(context.Background(), ID)
+	}
+
+	// Define GetContext backing function
+	service.GetContextFunc = func(ctx context.Context, ID uint) (*PermitModel, error) {
+		return nil, ErrNotImplemented
 	}
 
 	// Define Count backing function
 	service.CountFunc = func() (int, error) {
-		return 0, errors.New("not implemented")
+		return service.CountContextFunc(context.Background())
+	}
+
+	// Define CountContext backing function
+	service.CountContextFunc = func(ctx context.Context) (int, error) {
+		return 0, ErrNotImplemented
 	}
 
 	// Define List backing function
 	service.ListFunc = func(from int, size int, sort []Sort, ids []uint, aggregate bool) ([]*PermitModel, error) {
-		return nil, errors.New("not implemented")
+		return service.ListContextFunc(context.Background(), from, size, sort, ids, aggregate)
+	}
+
+	// Define ListContext backing function
+	service.ListContextFunc = func(ctx context.Context, from int, size int, sort []Sort, ids []uint, aggregate bool) ([]*PermitModel, error) {
+		return nil, ErrNotImplemented
 	}
 
 	// Define AmendWellPermits backing function
 	service.AmendWellPermitsFunc = func(wellID uint, amendWellPermitsRequest AmendWellPermitsRequest) ([]PermitModel, error) {
-		uri := fmt.Sprintf("%s/wells/%d/%s/amend.json", service.Spec.Client.URL.String(), wellID, service.Spec.ServiceName)
-		jsonStr, err := json.Marshal(amendWellPermitsRequest)
-		if err != nil {
-			return nil, err
-		}
-		req, err := http.NewRequest("PATCH", uri, bytes.NewBuffer(jsonStr))
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
-
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
-
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp.StatusCode != http.StatusAccepted {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
-		}
+		return service.AmendWellPermitsContextFunc(context.Background(), wellID, amendWellPermitsRequest)
+	}
 
+	// Define AmendWellPermitsContext backing function
+	service.AmendWellPermitsContextFunc = func(ctx context.Context, wellID uint, amendWellPermitsRequest AmendWellPermitsRequest) ([]PermitModel, error) {
 		var amendedPermits []PermitModel
-		err = json.Unmarshal(bodyBytes, &amendedPermits)
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodPatch,
+			Path:           fmt.Sprintf("wells/%d/%s/amend.json", wellID, service.Spec.ServiceName),
+			Body:           amendWellPermitsRequest,
+			ExpectedStatus: http.StatusAccepted,
+			Result:         &amendedPermits,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -106,17 +107,37 @@ func (service *DefaultPermitService) Get(ID uint) (*PermitModel, error) {
 	return service.GetFunc(ID)
 }
 
-// AmendWellPermits amend well's permits
-func (service *DefaultPermitService) List(from int, size int, sort []Sort, ids []uint, aggregate bool) ([]*PermitModel, error) {
-	return service.ListFunc(from, size, sort, ids, aggregate)
+// GetContext permit by id using the provided context
+func (service *DefaultPermitService) GetContext(ctx context.Context, ID uint) (*PermitModel, error) {
+	return service.GetContextFunc(ctx, ID)
 }
 
-// AmendWellPermits amend well's permits
+// Count Get a total number of permits
 func (service *DefaultPermitService) Count() (int, error) {
 	return service.CountFunc()
 }
 
+// CountContext Get a total number of permits using the provided context
+func (service *DefaultPermitService) CountContext(ctx context.Context) (int, error) {
+	return service.CountContextFunc(ctx)
+}
+
+// List List permits
+func (service *DefaultPermitService) List(from int, size int, sort []Sort, ids []uint, aggregate bool) ([]*PermitModel, error) {
+	return service.ListFunc(from, size, sort, ids, aggregate)
+}
+
+// ListContext List permits using the provided context
+func (service *DefaultPermitService) ListContext(ctx context.Context, from int, size int, sort []Sort, ids []uint, aggregate bool) ([]*PermitModel, error) {
+	return service.ListContextFunc(ctx, from, size, sort, ids, aggregate)
+}
+
 // AmendWellPermits amend well's permits
 func (service *DefaultPermitService) AmendWellPermits(wellID uint, amendWellPermitsRequest AmendWellPermitsRequest) ([]PermitModel, error) {
 	return service.AmendWellPermitsFunc(wellID, amendWellPermitsRequest)
 }
+
+// AmendWellPermitsContext amend well's permits using the provided context
+func (service *DefaultPermitService) AmendWellPermitsContext(ctx context.Context, wellID uint, amendWellPermitsRequest AmendWellPermitsRequest) ([]PermitModel, error) {
+	return service.AmendWellPermitsContextFunc(ctx, wellID, amendWellPermitsRequest)
+}
@@ -0,0 +1,158 @@
+package hydros
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ReplacementChain wells linked by WellReplacementID, from the oldest replaced well to the newest replacement
+type ReplacementChain struct {
+	// WellID well the chain was resolved from
+	WellID uint
+	// Wells lineage, oldest first.  A well replaced by several wells is followed by all of them, ordered by ID
+	Wells []*WellModel
+
+	client *Client
+}
+
+// ReplacementCycleError returned when WellReplacementID links wells in a loop
+type ReplacementCycleError struct {
+	// WellIDs wells visited until the loop closed, ending with the repeated ID
+	WellIDs []uint
+}
+
+// Error lists the wells forming the loop
+func (e *ReplacementCycleError) Error() string {
+	ids := make([]string, len(e.WellIDs))
+	for i, id := range e.WellIDs {
+		ids[i] = fmt.Sprint(id)
+	}
+	return fmt.Sprintf("well replacement cycle: %s", strings.Join(ids, " -> "))
+}
+
+// GetReplacementChain resolves the wells the given well replaced, following WellReplacementID, and the wells that
+// replaced it, found by searching for wells referencing each ID in turn
+func (service *DefaultWellService) GetReplacementChain(wellID uint) (*ReplacementChain, error) {
+	return service.GetReplacementChainFunc(wellID)
+}
+
+// GetReplacementChainContext resolves the replacement chain of the given well using the provided context
+func (service *DefaultWellService) GetReplacementChainContext(ctx context.Context, wellID uint) (*ReplacementChain, error) {
+	return service.GetReplacementChainContextFunc(ctx, wellID)
+}
+
+// getReplacementChain default GetReplacementChainContext backing function
+func (service *DefaultWellService) getReplacementChain(ctx context.Context, wellID uint) (*ReplacementChain, error) {
+	well, err := service.GetContext(ctx, wellID)
+	if err != nil {
+		return nil, err
+	}
+	visited := map[uint]bool{well.ID: true}
+
+	// Ancestors, newest first until reversed
+	ancestors := []*WellModel{well}
+	path := []uint{well.ID}
+	for current := well; current.WellReplacementID != 0; {
+		path = append(path, current.WellReplacementID)
+		if visited[current.WellReplacementID] {
+			return nil, &ReplacementCycleError{WellIDs: path}
+		}
+		visited[current.WellReplacementID] = true
+		if current, err = service.GetContext(ctx, current.WellReplacementID); err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, current)
+	}
+	wells := make([]*WellModel, 0, len(ancestors))
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		wells = append(wells, ancestors[i])
+	}
+
+	// Descendants, breadth first
+	for queue := []*WellModel{well}; len(queue) > 0; queue = queue[1:] {
+		parent := queue[0]
+		var replacements []*WellModel
+		it := service.SearchAllContextFunc(ctx, NewWellSearchQuery().ReplacementOf(parent.ID))
+		for it.Next() {
+			replacement := it.Well()
+			if replacement.WellReplacementID != parent.ID {
+				continue
+			}
+			if visited[replacement.ID] {
+				return nil, &ReplacementCycleError{WellIDs: []uint{parent.ID, replacement.ID}}
+			}
+			visited[replacement.ID] = true
+			replacements = append(replacements, replacement)
+		}
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+		sort.Slice(replacements, func(i, j int) bool { return replacements[i].ID < replacements[j].ID })
+		wells = append(wells, replacements...)
+		queue = append(queue, replacements...)
+	}
+
+	return &ReplacementChain{WellID: wellID, Wells: wells, client: service.Spec.Client}, nil
+}
+
+// Ancestors wells replaced, directly or indirectly, by the well the chain was resolved from, oldest first
+func (chain *ReplacementChain) Ancestors() []*WellModel {
+	for i, well := range chain.Wells {
+		if well.ID == chain.WellID {
+			return chain.Wells[:i]
+		}
+	}
+	return nil
+}
+
+// Descendants wells replacing, directly or indirectly, the well the chain was resolved from
+func (chain *ReplacementChain) Descendants() []*WellModel {
+	for i, well := range chain.Wells {
+		if well.ID == chain.WellID {
+			return chain.Wells[i+1:]
+		}
+	}
+	return nil
+}
+
+// WellProduction production of a single well in a replacement chain
+type WellProduction struct {
+	WellID     uint
+	Production []ProductionModel
+	// Volume total volume of the well's meters
+	Volume float64
+}
+
+// ReplacementProduction production aggregated across a replacement chain
+type ReplacementProduction struct {
+	// Wells production per well, in chain order
+	Wells []WellProduction
+	// Volume total volume of all wells in the chain
+	Volume float64
+	// Estimated whether any volume was estimated
+	Estimated bool
+}
+
+// Production aggregates MeterReadingService.GetProductionByWell over every well in the chain
+func (chain *ReplacementChain) Production(ctx context.Context, fromDate *time.Time, toDate *time.Time,
+	estimateBounds bool) (*ReplacementProduction, error) {
+
+	total := &ReplacementProduction{Wells: make([]WellProduction, 0, len(chain.Wells))}
+	for _, well := range chain.Wells {
+		production, err := chain.client.MeterReading.GetProductionByWellContext(ctx, well.ID, fromDate, toDate, estimateBounds)
+		if err != nil {
+			return nil, err
+		}
+		wellProduction := WellProduction{WellID: well.ID, Production: production}
+		for _, meterProduction := range production {
+			wellProduction.Volume += meterProduction.Volume
+			total.Estimated = total.Estimated || meterProduction.Estimated
+		}
+		total.Volume += wellProduction.Volume
+		total.Wells = append(total.Wells, wellProduction)
+	}
+	return total, nil
+}
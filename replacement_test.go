@@ -0,0 +1,107 @@
+package hydros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// replacementServer serves wells by ID, replacement searches and production from replacedBy, a map of well ID to
+// the ID of the well it replaced
+func replacementServer(replacedBy map[uint]uint) *httptest.Server {
+	wellJSON := func(id uint) string {
+		return fmt.Sprintf(`{"id":%d,"wellReplacementId":%d}`, id, replacedBy[id])
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var id uint
+		switch {
+		case r.URL.Path == "/wells/search.json":
+			var parent uint
+			_, _ = fmt.Sscanf(r.URL.Query().Get("filters"), "wellReplacementId:%d", &parent)
+			var results []string
+			for child, replaced := range replacedBy {
+				if replaced == parent {
+					results = append(results, wellJSON(child))
+				}
+			}
+			_, _ = fmt.Fprintf(w, `{"total":%d,"results":[%s]}`, len(results), strings.Join(results, ","))
+		case strings.HasSuffix(r.URL.Path, "/production.json"):
+			_, _ = fmt.Sscanf(r.URL.Path, "/wells/%d/production.json", &id)
+			_, _ = fmt.Fprintf(w, `[{"meterId":1,"volume":%d},{"meterId":2,"volume":1,"estimated":%t}]`, id*10, id == 4)
+		default:
+			_, _ = fmt.Sscanf(r.URL.Path, "/wells/%d.json", &id)
+			if _, ok := replacedBy[id]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(wellJSON(id)))
+		}
+	}))
+}
+
+func TestWellService_GetReplacementChain(t *testing.T) {
+
+	server := replacementServer(map[uint]uint{1: 0, 2: 1, 3: 2, 4: 3, 5: 3})
+	defer server.Close()
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	chain, err := client.Well.GetReplacementChain(2)
+	assert.Nil(t, err, "Error should be nil.")
+	ids := func(wells []*WellModel) []uint {
+		var ids []uint
+		for _, well := range wells {
+			ids = append(ids, well.ID)
+		}
+		return ids
+	}
+	assert.Equal(t, []uint{1, 2, 3, 4, 5}, ids(chain.Wells))
+	assert.Equal(t, []uint{1}, ids(chain.Ancestors()))
+	assert.Equal(t, []uint{3, 4, 5}, ids(chain.Descendants()))
+
+	production, err := chain.Production(context.Background(), nil, nil, true)
+	assert.Nil(t, err, "Error should be nil.")
+	if assert.Len(t, production.Wells, 5) {
+		assert.Equal(t, uint(3), production.Wells[2].WellID)
+		assert.Equal(t, 31.0, production.Wells[2].Volume)
+	}
+	assert.Equal(t, 155.0, production.Volume)
+	assert.True(t, production.Estimated)
+}
+
+func TestWellService_GetReplacementChain_Cycle(t *testing.T) {
+
+	server := replacementServer(map[uint]uint{1: 3, 2: 1, 3: 2})
+	defer server.Close()
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Well.GetReplacementChain(2)
+	var cycleError *ReplacementCycleError
+	if assert.True(t, errors.As(err, &cycleError)) {
+		assert.Equal(t, []uint{2, 1, 3, 2}, cycleError.WellIDs)
+	}
+	assert.EqualError(t, err, "well replacement cycle: 2 -> 1 -> 3 -> 2")
+}
+
+func TestWellService_GetReplacementChainMock(t *testing.T) {
+
+	client, err := NewClient()
+	assert.Nil(t, err, "Error should be nil.")
+
+	chain := &ReplacementChain{WellID: 2}
+	err = MockServiceMethod(client, "Well.GetReplacementChainContext",
+		func(ctx context.Context, wellID uint) (*ReplacementChain, error) {
+			return chain, nil
+		})
+	assert.Nil(t, err, "Error should be nil.")
+
+	found, err := client.Well.GetReplacementChainContext(context.Background(), 2)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, chain, found)
+}
@@ -0,0 +1,183 @@
+package hydros
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// apiRequest describes a single call against the Hydros API
+type apiRequest struct {
+	// Method HTTP method (e.g. GET, POST, PATCH)
+	Method string
+	// Path is relative to the client URL (e.g. "wells/1.json")
+	Path string
+	// Query optional query string parameters
+	Query url.Values
+	// Body optional request payload.  A []byte is sent as-is, anything else is JSON encoded
+	Body interface{}
+	// ExpectedStatus status code signaling success.  Zero accepts any 2xx status
+	ExpectedStatus int
+	// Result optional pointer the response body is JSON decoded into
+	Result interface{}
+	// Headers optional headers sent in addition to the client headers
+	Headers []RequestHeader
+	// ResponseHeader headers of the final response, set by execute
+	ResponseHeader http.Header
+}
+
+// execute builds, sends and decodes an API request.  All services and models make their calls through here.
+func (client *Client) execute(ctx context.Context, request *apiRequest) error {
+
+	payload, err := request.encodeBody()
+	if err != nil {
+		return err
+	}
+
+	var (
+		req          *http.Request
+		resp         *http.Response
+		bodyBytes    []byte
+		refreshed    bool
+		invalidating *reuseTokenSource
+	)
+	if reuse, ok := client.TokenSource.(*reuseTokenSource); ok {
+		invalidating = reuse
+	}
+	for attempt := 1; ; attempt++ {
+		var token *Token
+		if client.TokenSource != nil && client.AuthType == AuthTypeOpenID {
+			if token, err = client.TokenSource.Token(ctx); err != nil {
+				return err
+			}
+		}
+
+		req, resp, bodyBytes, err = client.send(ctx, request, payload, token, attempt)
+
+		// Refresh the token once if it was rejected.  This does not count as a retry attempt
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && invalidating != nil && !refreshed {
+			invalidating.invalidate(token)
+			refreshed = true
+			attempt--
+			continue
+		}
+
+		if !client.RetryPolicy.shouldRetry(ctx, attempt, request.Method, resp, err) {
+			break
+		}
+		if err := sleepContext(ctx, client.RetryPolicy.backoff(attempt, resp)); err != nil {
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+	request.ResponseHeader = resp.Header
+
+	if !request.isExpectedStatus(resp.StatusCode) {
+		apiError := &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       bodyBytes,
+			Method:     req.Method,
+			URL:        req.URL.String(),
+			RequestID:  resp.Header.Get("X-Request-Id"),
+		}
+		var errorResponse ErrorResponse
+		if json.Unmarshal(bodyBytes, &errorResponse) == nil {
+			apiError.ErrorResponse = &errorResponse
+		}
+		return apiError
+	}
+
+	if request.Result == nil {
+		return nil
+	}
+	if len(bodyBytes) == 0 {
+		return ErrEmptyResponse
+	}
+	return json.Unmarshal(bodyBytes, request.Result)
+}
+
+// send performs a single attempt of the request and reads the full response body.  A non-nil token replaces the
+// Authorization header.  The built request is returned since responses from middleware may not reference it
+func (client *Client) send(ctx context.Context, request *apiRequest, payload []byte, token *Token,
+	attempt int) (*http.Request, *http.Response, []byte, error) {
+
+	if client.throttle != nil {
+		release, err := client.throttle.acquire(ctx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		defer release()
+	}
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	uri := fmt.Sprintf("%s/%s", strings.TrimSuffix(client.URL.String(), "/"), strings.TrimPrefix(request.Path, "/"))
+	req, err := http.NewRequestWithContext(ctx, request.Method, uri, body)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(request.Query) > 0 {
+		req.URL.RawQuery = request.Query.Encode()
+	}
+
+	headers := client.CreateHeadersFunc()
+	for h := 0; h < len(headers); h++ {
+		req.Header.Add(headers[h].Key, headers[h].Value)
+	}
+	for _, header := range request.Headers {
+		req.Header.Set(header.Key, header.Value)
+	}
+	if token != nil {
+		req.Header.Set("Authorization", fmt.Sprintf("%s %s", token.Type(), token.AccessToken))
+	}
+
+	start := time.Now()
+	resp, err := client.doer().Do(req)
+	if err != nil {
+		client.logAttempt(req, payload, nil, nil, err, attempt, time.Since(start))
+		return req, nil, nil, err
+	}
+
+	var bodyBytes []byte
+	if resp.Body != nil {
+		defer resp.Body.Close()
+		bodyBytes, err = ioutil.ReadAll(resp.Body)
+	}
+	client.logAttempt(req, payload, resp, bodyBytes, err, attempt, time.Since(start))
+	if err != nil {
+		return req, nil, nil, err
+	}
+	return req, resp, bodyBytes, nil
+}
+
+// encodeBody returns the request payload bytes.  A []byte body is sent as-is, anything else is JSON encoded
+func (request *apiRequest) encodeBody() ([]byte, error) {
+	switch body := request.Body.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return body, nil
+	default:
+		return json.Marshal(body)
+	}
+}
+
+// isExpectedStatus checks whether the response status code signals success for the request
+func (request *apiRequest) isExpectedStatus(statusCode int) bool {
+	if request.ExpectedStatus == 0 {
+		return statusCode >= 200 && statusCode < 300
+	}
+	return statusCode == request.ExpectedStatus
+}
@@ -0,0 +1,132 @@
+package hydros
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestClientExecute(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/wells/wellsByIDs.json", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("size"))
+		assert.Equal(t, "Bearer 2718281828259", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, `{"ids":[1,2]}`, string(body))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[{"id":1},{"id":2}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL+"/api"), SetAccessToken("2718281828259"))
+	assert.Nil(t, err, "Error should be nil.")
+
+	var wells []WellModel
+	err = client.execute(context.Background(), &apiRequest{
+		Method:         http.MethodPost,
+		Path:           "wells/wellsByIDs.json",
+		Query:          url.Values{"size": []string{"2"}},
+		Body:           map[string][]uint{"ids": {1, 2}},
+		ExpectedStatus: http.StatusOK,
+		Result:         &wells,
+	})
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Len(t, wells, 2)
+	assert.Equal(t, uint(2), wells[1].ID)
+}
+
+func TestClientExecute_UnexpectedStatus(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/message.json":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found","description":"well does not exist"}`))
+		case "/accepted.json":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`boom`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	err = client.execute(context.Background(), &apiRequest{Method: http.MethodGet, Path: "message.json"})
+	assert.NotNil(t, err, "Error should not be nil.")
+	assert.Equal(t, "not found: well does not exist", err.Error())
+
+	err = client.execute(context.Background(), &apiRequest{Method: http.MethodGet, Path: "other.json"})
+	assert.NotNil(t, err, "Error should not be nil.")
+	assert.Equal(t, "500 error: boom", err.Error())
+
+	err = client.execute(context.Background(), &apiRequest{
+		Method:         http.MethodPatch,
+		Path:           "accepted.json",
+		ExpectedStatus: http.StatusAccepted,
+	})
+	assert.NotNil(t, err, "Error should not be nil.")
+
+	err = client.execute(context.Background(), &apiRequest{Method: http.MethodGet, Path: "accepted.json"})
+	assert.Nil(t, err, "Error should be nil.")
+}
+
+func TestClientExecute_EmptyBody(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Well.Create(&WellModel{Serial: "W-1"})
+	assert.Equal(t, ErrEmptyResponse, err)
+
+	well := (&WellModel{DefaultModelBase: &DefaultModelBase{ID: 1}}).Init(client.Well._ServiceSpec())
+	_, err = well.Update([]byte(`{"serial":"W-2"}`))
+	assert.Equal(t, ErrEmptyResponse, err)
+	_, err = well.TriggerUpdate()
+	assert.Equal(t, ErrEmptyResponse, err)
+
+	// Requests without a result accept an empty body
+	err = client.execute(context.Background(), &apiRequest{Method: http.MethodPost, Path: "wells.json",
+		ExpectedStatus: http.StatusCreated})
+	assert.Nil(t, err, "Error should be nil.")
+}
+
+func TestMeterReadingServiceGetProductionByWell_SendsQuery(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/wells/7/production.json", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("estimateBounds"))
+		_, _ = w.Write([]byte(`[{"meterId":3,"volume":12.5}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	production, err := client.MeterReading.GetProductionByWell(7, nil, nil, true)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Len(t, production, 1)
+	assert.Equal(t, 12.5, production[0].Volume)
+}
@@ -0,0 +1,149 @@
+package hydros
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries failed requests
+type RetryPolicy struct {
+	// MaxAttempts total number of attempts including the first.  Values below 2 disable retries
+	MaxAttempts int
+	// InitialBackoff wait before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff upper bound for the wait between attempts, including waits requested by Retry-After
+	MaxBackoff time.Duration
+	// Multiplier growth factor applied to the backoff after every attempt
+	Multiplier float64
+	// Jitter fraction (0-1) of the backoff that is randomized to spread out retries
+	Jitter float64
+	// RetryableStatuses response status codes that trigger a retry
+	RetryableStatuses []int
+	// RetryableMethods HTTP methods that may be retried.  Add http.MethodPatch or http.MethodPut to opt in
+	// non-GET calls such as WellModel.Update or PermitService.AmendWellPermits
+	RetryableMethods []string
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent GET requests on throttling, gateway errors and
+// connection failures
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 250 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableMethods: []string{http.MethodGet, http.MethodHead},
+	}
+}
+
+// SetRetryPolicy sets the policy used to retry failed requests.  A nil policy disables retries
+func SetRetryPolicy(policy *RetryPolicy) ClientOptionFunc {
+	return func(c *Client) error {
+		if policy != nil && (policy.Jitter < 0 || policy.Jitter > 1) {
+			return errors.New("retry policy jitter must be between 0 and 1")
+		}
+		c.RetryPolicy = policy
+		return nil
+	}
+}
+
+// shouldRetry decides if another attempt should be made after the given response or transport error
+func (policy *RetryPolicy) shouldRetry(ctx context.Context, attempt int, method string, resp *http.Response, err error) bool {
+	if policy == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+	if !policy.isRetryableMethod(method) {
+		return false
+	}
+	if err != nil {
+		// Transport errors such as connection resets are retryable, cancellation is not
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	for _, status := range policy.RetryableStatuses {
+		if resp.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+// isRetryableMethod checks method against the policy's retryable methods
+func (policy *RetryPolicy) isRetryableMethod(method string) bool {
+	for _, retryableMethod := range policy.RetryableMethods {
+		if retryableMethod == method {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff computes the wait before the next attempt, preferring the server's Retry-After header when present.  Both
+// are capped at MaxBackoff
+func (policy *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if policy.MaxBackoff > 0 && wait > policy.MaxBackoff {
+				wait = policy.MaxBackoff
+			}
+			return wait
+		}
+	}
+
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	wait := float64(policy.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if policy.MaxBackoff > 0 && wait > float64(policy.MaxBackoff) {
+		wait = float64(policy.MaxBackoff)
+	}
+	if policy.Jitter > 0 {
+		wait = wait - wait*policy.Jitter + wait*policy.Jitter*2*rand.Float64()
+	}
+	return time.Duration(wait)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
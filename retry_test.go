@@ -0,0 +1,160 @@
+package hydros
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func TestRetryPolicy_RetriesGet(t *testing.T) {
+
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":42}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL), SetRetryPolicy(testRetryPolicy()))
+	assert.Nil(t, err, "Error should be nil.")
+
+	well, err := client.Well.Get(42)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, uint(42), well.ID)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryPolicy_GivesUpAfterMaxAttempts(t *testing.T) {
+
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL), SetRetryPolicy(testRetryPolicy()))
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Well.Get(42)
+	assert.NotNil(t, err, "Error should not be nil.")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryPolicy_PatchIsOptIn(t *testing.T) {
+
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"id":7}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL), SetRetryPolicy(testRetryPolicy()))
+	assert.Nil(t, err, "Error should be nil.")
+
+	well := (&WellModel{DefaultModelBase: &DefaultModelBase{ID: 7}}).Init(client.Well._ServiceSpec())
+	_, err = well.Update([]byte(`{"name":"test"}`))
+	assert.NotNil(t, err, "PATCH should not be retried by default")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+
+	atomic.StoreInt32(&attempts, 0)
+	policy := testRetryPolicy()
+	policy.RetryableMethods = append(policy.RetryableMethods, http.MethodPatch)
+	client, err = NewClient(SetHost(server.URL), SetRetryPolicy(policy))
+	assert.Nil(t, err, "Error should be nil.")
+
+	well = (&WellModel{DefaultModelBase: &DefaultModelBase{ID: 7}}).Init(client.Well._ServiceSpec())
+	updatedWell, err := well.Update([]byte(`{"name":"test"}`))
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, uint(7), updatedWell.ID)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryPolicy_StopsOnContextCancel(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxBackoff = 0
+	client, err := NewClient(SetHost(server.URL), SetRetryPolicy(policy))
+	assert.Nil(t, err, "Error should be nil.")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.Well.GetContext(ctx, 1)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < 5*time.Second, "Should not wait out Retry-After once canceled")
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+
+	policy := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1, nil))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2, nil))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3, nil))
+	assert.Equal(t, time.Second, policy.backoff(5, nil))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		wait := policy.backoff(1, nil)
+		assert.True(t, wait >= 50*time.Millisecond && wait <= 150*time.Millisecond, "Jittered backoff out of range")
+	}
+
+	// Retry-After is honored up to MaxBackoff
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	assert.Equal(t, time.Second, policy.backoff(1, resp))
+	policy.MaxBackoff = 0
+	assert.Equal(t, 3*time.Second, policy.backoff(1, resp))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+
+	now := time.Date(2021, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	wait, ok := parseRetryAfter("120", now)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, wait)
+
+	wait, ok = parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, wait)
+
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+}
+
+func TestSetRetryPolicy_InvalidJitter(t *testing.T) {
+
+	policy := DefaultRetryPolicy()
+	policy.Jitter = 1.5
+	client, err := NewClient(SetRetryPolicy(policy))
+	assert.Nil(t, client, "Client should be nil")
+	assert.NotNil(t, err, "Error should not be nil.")
+}
@@ -0,0 +1,158 @@
+package hydros
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// SaveMode how models send their changes when saved
+type SaveMode string
+
+// SaveMode constants
+const (
+	// SaveChangedFields sends a JSON merge patch of the fields changed since the model was fetched
+	SaveChangedFields SaveMode = "changedFields"
+	// SaveFullModel sends the full model
+	SaveFullModel SaveMode = "fullModel"
+)
+
+// DefaultSaveMode default save mode
+const DefaultSaveMode = SaveChangedFields
+
+// SetSaveMode sets whether Save sends only changed fields or the full model
+func SetSaveMode(mode SaveMode) ClientOptionFunc {
+	return func(c *Client) error {
+		if mode != SaveChangedFields && mode != SaveFullModel {
+			return fmt.Errorf("unknown save mode '%s'", mode)
+		}
+		c.SaveMode = mode
+		return nil
+	}
+}
+
+// saveMode returns the configured save mode, falling back to the default
+func (client *Client) saveMode() SaveMode {
+	if client.SaveMode == "" {
+		return DefaultSaveMode
+	}
+	return client.SaveMode
+}
+
+// readOnlyPatchKeys top level keys the server assigns, left out of merge patches
+var readOnlyPatchKeys = []string{"id", "createdAt", "updatedAt"}
+
+// MergePatch creates a JSON merge patch (RFC 7396) turning original into modified, two values of the same model type
+// such as *WellModel.  Cleared null.* values and associations are sent as null, while bool, number, string and list
+// fields dropped by omitempty are sent as their zero value.  IDs and timestamps are never patched.  A nil patch means
+// there are no changes
+func MergePatch(original interface{}, modified interface{}) ([]byte, error) {
+	modelType := reflect.TypeOf(original)
+	if modelType != reflect.TypeOf(modified) {
+		return nil, fmt.Errorf("cannot diff %T against %T", original, modified)
+	}
+	originalBytes, err := json.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+	modifiedBytes, err := json.Marshal(modified)
+	if err != nil {
+		return nil, err
+	}
+	return mergePatch(originalBytes, modifiedBytes, modelType)
+}
+
+// mergePatch creates a JSON merge patch (RFC 7396) turning original into modified, the JSON encodings of modelType.
+// A nil modelType diffs the documents as plain JSON.  A nil patch means there are no changes
+func mergePatch(original []byte, modified []byte, modelType reflect.Type) ([]byte, error) {
+	var originalDoc, modifiedDoc map[string]interface{}
+	if err := json.Unmarshal(original, &originalDoc); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(modified, &modifiedDoc); err != nil {
+		return nil, err
+	}
+	patch := diffObjects(originalDoc, modifiedDoc)
+	if modelType != nil {
+		for _, key := range readOnlyPatchKeys {
+			delete(patch, key)
+		}
+		fillOmittedValues(patch, modelType)
+	}
+	if len(patch) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(patch)
+}
+
+// fillOmittedValues replaces the nulls diffObjects sets for fields dropped by omitempty with the field's zero value,
+// so clearing e.g. a bool sends false rather than removing the field
+func fillOmittedValues(patch map[string]interface{}, modelType reflect.Type) {
+	for modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+	if modelType.Kind() != reflect.Struct {
+		return
+	}
+	for key, value := range patch {
+		field, ok := findJSONField(modelType, key)
+		if !ok {
+			continue
+		}
+		switch typed := value.(type) {
+		case nil:
+			if zero, ok := omittedZeroValue(field.Type); ok {
+				patch[key] = zero
+			}
+		case map[string]interface{}:
+			fillOmittedValues(typed, field.Type)
+		}
+	}
+}
+
+// omittedZeroValue JSON zero value of fieldType, for kinds that cannot be null
+func omittedZeroValue(fieldType reflect.Type) (interface{}, bool) {
+	switch fieldType.Kind() {
+	case reflect.Bool:
+		return false, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return 0, true
+	case reflect.String:
+		return "", true
+	case reflect.Slice, reflect.Array:
+		return []interface{}{}, true
+	}
+	return nil, false
+}
+
+// diffObjects returns the merge patch between two decoded JSON objects.  Removed keys are set to null, nested objects
+// are diffed recursively and any other changed value, including arrays, is replaced as a whole
+func diffObjects(original map[string]interface{}, modified map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{})
+	for key, originalValue := range original {
+		if _, ok := modified[key]; !ok {
+			patch[key] = nil
+			continue
+		}
+		modifiedValue := modified[key]
+		originalObject, originalIsObject := originalValue.(map[string]interface{})
+		modifiedObject, modifiedIsObject := modifiedValue.(map[string]interface{})
+		if originalIsObject && modifiedIsObject {
+			if nested := diffObjects(originalObject, modifiedObject); len(nested) > 0 {
+				patch[key] = nested
+			}
+			continue
+		}
+		if !reflect.DeepEqual(originalValue, modifiedValue) {
+			patch[key] = modifiedValue
+		}
+	}
+	for key, modifiedValue := range modified {
+		if _, ok := original[key]; !ok {
+			patch[key] = modifiedValue
+		}
+	}
+	return patch
+}
@@ -0,0 +1,111 @@
+package hydros
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+
+	patch, err := mergePatch(
+		[]byte(`{"a":1,"b":{"c":"x","d":"y"},"e":[1,2],"f":true}`),
+		[]byte(`{"a":1,"b":{"c":"z","d":"y"},"e":[1],"g":"new"}`), nil)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.JSONEq(t, `{"b":{"c":"z"},"e":[1],"f":null,"g":"new"}`, string(patch))
+
+	patch, err = mergePatch([]byte(`{"a":{"b":1}}`), []byte(`{"a":{"b":1}}`), nil)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Nil(t, patch, "Patch should be nil when nothing changed")
+
+	_, err = mergePatch([]byte(`{`), []byte(`{}`), nil)
+	assert.NotNil(t, err, "Error should not be nil.")
+}
+
+func TestMergePatch_WellModel(t *testing.T) {
+
+	original := &WellModel{
+		DefaultModelBase: &DefaultModelBase{ID: 7},
+		Serial:           "W-7",
+		Exempt:           true,
+		SystemID:         3,
+		Notes:            null.StringFrom("old"),
+		Location:         &LocationModel{County: null.StringFrom("Lipscomb"), Latitude: null.FloatFrom(36.2)},
+		Construction:     &ConstructionModel{CasingSize: null.FloatFrom(6)},
+		WellUses:         []WellUse{{WellUse: "Domestic"}},
+	}
+	modified, err := original.Clone()
+	assert.Nil(t, err, "Error should be nil.")
+	modified.ID = 0
+	modified.Exempt = false
+	modified.SystemID = 0
+	modified.Notes = null.String{}
+	modified.Location.County = null.StringFrom("Ochiltree")
+	modified.Construction = nil
+	modified.WellUses = nil
+	assert.Equal(t, "Lipscomb", original.Location.County.String, "Clone should not share associations")
+
+	patch, err := MergePatch(original, modified)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.JSONEq(t, `{"exempt":false,"systemId":0,"notes":null,"location":{"county":"Ochiltree"},"construction":null,"wellUses":[]}`,
+		string(patch))
+
+	patch, err = MergePatch(original, original)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Nil(t, patch, "Patch should be nil when nothing changed")
+
+	_, err = MergePatch(original, &MeterModel{})
+	assert.NotNil(t, err, "Error should not be nil.")
+}
+
+func TestWellModel_UpdateFrom(t *testing.T) {
+
+	var requests int
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, http.MethodPatch, r.Method)
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"id":7,"serial":"W-7","approved":true}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+	well := (&WellModel{DefaultModelBase: &DefaultModelBase{ID: 7}, Serial: "W-7"}).Init(client.Well._ServiceSpec())
+
+	modified, err := well.Clone()
+	assert.Nil(t, err, "Error should be nil.")
+	unchanged, err := well.UpdateFrom(modified)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, well, unchanged)
+	assert.Equal(t, 0, requests)
+
+	modified.Approved = true
+	updated, err := well.UpdateFrom(modified)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, 1, requests)
+	assert.JSONEq(t, `{"approved":true}`, string(body))
+	assert.True(t, updated.Approved)
+}
+
+func TestSetSaveMode(t *testing.T) {
+
+	client, err := NewClient()
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, SaveChangedFields, client.saveMode())
+
+	client, err = NewClient(SetSaveMode(SaveFullModel))
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, SaveFullModel, client.saveMode())
+}
+
+func TestSetSaveMode_Unknown(t *testing.T) {
+
+	_, err := NewClient(SetSaveMode("partial"))
+	assert.EqualError(t, err, "unknown save mode 'partial'")
+}
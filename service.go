@@ -1,9 +1,15 @@
 package hydros
 
 import (
+	"fmt"
+	"net/url"
 	"reflect"
+	"strings"
 )
 
+// MaxPageSize maximum number of results the API returns per page
+const MaxPageSize = 150
+
 // Service Base interface for services
 type Service interface {
 	_ServiceSpec() *ServiceSpec
@@ -52,3 +58,40 @@ func (service *DefaultService) _SetModelServiceCallMock(targetMethodName string,
 		service.Spec.ModelServiceCallMocks[targetMethodName] = ModelServiceCallMocks
 	}
 }
+
+// countResponse count endpoint response payload
+type countResponse struct {
+	Count int `json:"count"`
+}
+
+// pageQuery builds the from, size, sort and ids query parameters shared by list and search endpoints
+func pageQuery(from int, size int, sorts []Sort, ids []uint) (url.Values, error) {
+	if from < 0 {
+		return nil, fmt.Errorf("from parameter must not be negative")
+	}
+	if size < 0 {
+		return nil, fmt.Errorf("size parameter must not be negative")
+	}
+	if size > MaxPageSize {
+		return nil, fmt.Errorf("size parameter must not exceed %d", MaxPageSize)
+	}
+
+	q := url.Values{}
+	if len(sorts) > 0 {
+		var sortStr []string
+		for _, sort := range sorts {
+			sortStr = append(sortStr, fmt.Sprint(sort.Field, ":", sort.Direction))
+		}
+		q.Add("sort", strings.Join(sortStr, ","))
+	}
+	if len(ids) > 0 {
+		idStr := make([]string, len(ids))
+		for i, id := range ids {
+			idStr[i] = fmt.Sprint(id)
+		}
+		q.Add("ids", strings.Join(idStr, ","))
+	}
+	q.Add("from", fmt.Sprint(from))
+	q.Add("size", fmt.Sprint(size))
+	return q, nil
+}
@@ -0,0 +1,291 @@
+package hydros
+
+import (
+	"fmt"
+)
+
+// SpacingRule identifies the rule a spacing finding belongs to
+type SpacingRule string
+
+// SpacingRule constants
+const (
+	SpacingRuleMinTractSize      SpacingRule = "minTractSize"
+	SpacingRulePropertyLine      SpacingRule = "propertyLine"
+	SpacingRuleWellOnProperty    SpacingRule = "wellOnProperty"
+	SpacingRuleNeighboringWell   SpacingRule = "neighboringWell"
+	SpacingRuleExistingWaterWell SpacingRule = "existingWaterWell"
+)
+
+// SpacingResult outcome of a single spacing check
+type SpacingResult string
+
+// SpacingResult constants
+const (
+	SpacingPass        SpacingResult = "pass"
+	SpacingFail        SpacingResult = "fail"
+	SpacingMissingData SpacingResult = "missingData"
+)
+
+// SpacingTier required distance for wells meeting a casing size or production threshold.  The most restrictive
+// tier a well meets applies
+type SpacingTier struct {
+	// MinCasingSize casing size in inches from which the tier applies
+	MinCasingSize float64
+	// MinProduction maximum pump production in gallons per minute from which the tier applies
+	MinProduction int64
+	// Distance required distance
+	Distance Distance
+}
+
+// SpacingRules district spacing requirements.  Zero values disable a rule
+type SpacingRules struct {
+	// MinTractAcres minimum contiguous acreage of the tract the well is located on
+	MinTractAcres float64
+	// PropertyLineSetbacks required distance to each property line
+	PropertyLineSetbacks []SpacingTier
+	// WellSpacing required distance to other wells
+	WellSpacing []SpacingTier
+}
+
+// SpacingFinding result of a single spacing check
+type SpacingFinding struct {
+	Rule   SpacingRule
+	Result SpacingResult
+	// Required distance or acreage required by the rule
+	Required float64
+	// Actual distance or acreage found, zero when data is missing
+	Actual float64
+	// NeighborID well the finding refers to, for neighboring well checks
+	NeighborID uint
+	Message    string
+}
+
+// SpacingReport findings of a spacing evaluation
+type SpacingReport struct {
+	WellID   uint
+	Findings []SpacingFinding
+}
+
+// Passed reports whether every check passed
+func (report *SpacingReport) Passed() bool {
+	for _, finding := range report.Findings {
+		if finding.Result != SpacingPass {
+			return false
+		}
+	}
+	return true
+}
+
+// Failures findings that did not pass, including checks lacking data
+func (report *SpacingReport) Failures() []SpacingFinding {
+	var failures []SpacingFinding
+	for _, finding := range report.Findings {
+		if finding.Result != SpacingPass {
+			failures = append(failures, finding)
+		}
+	}
+	return failures
+}
+
+// MaxWellSpacing largest well spacing any tier requires, e.g. the radius to search for neighbors in
+func (rules *SpacingRules) MaxWellSpacing() Distance {
+	var max Distance
+	for _, tier := range rules.WellSpacing {
+		if tier.Distance > max {
+			max = tier.Distance
+		}
+	}
+	return max
+}
+
+// Evaluate checks well against the rules.  Distances recorded on the well's location are in feet.  neighbors are
+// other wells to check spacing against, e.g. from SearchWithinRadius using MaxWellSpacing.  Applicant
+// certifications stand in for missing tract and distance data
+func (rules *SpacingRules) Evaluate(well *WellModel, neighbors []*WellModel) *SpacingReport {
+	report := &SpacingReport{}
+	if well.DefaultModelBase != nil {
+		report.WellID = well.ID
+	}
+	location := well.Location
+	if location == nil {
+		location = &LocationModel{}
+	}
+
+	if rules.MinTractAcres > 0 {
+		report.Findings = append(report.Findings, rules.checkTract(well, location))
+	}
+
+	if setback, ok := rules.requiredDistance(rules.PropertyLineSetbacks, well); ok {
+		report.Findings = append(report.Findings, rules.checkPropertyLines(well, location, setback)...)
+	}
+
+	if spacing, ok := rules.requiredDistance(rules.WellSpacing, well); ok {
+		report.Findings = append(report.Findings, rules.checkWellSpacing(well, location, neighbors, spacing)...)
+	}
+	return report
+}
+
+// checkTract checks the contiguous acreage of the tract
+func (rules *SpacingRules) checkTract(well *WellModel, location *LocationModel) SpacingFinding {
+	finding := SpacingFinding{Rule: SpacingRuleMinTractSize, Required: rules.MinTractAcres}
+	switch {
+	case location.ContinuousAcredTotal.Valid:
+		finding.Actual = location.ContinuousAcredTotal.Float64
+		finding.Result = passOrFail(finding.Actual >= rules.MinTractAcres)
+		finding.Message = fmt.Sprintf("tract is %.2f acres, %.2f required", finding.Actual, rules.MinTractAcres)
+	case well.CertifiedMinTractSize:
+		finding.Result = SpacingPass
+		finding.Message = "tract size certified by applicant"
+	default:
+		finding.Result = SpacingMissingData
+		finding.Message = "tract acreage is missing"
+	}
+	return finding
+}
+
+// checkPropertyLines checks the distance to each recorded property line
+func (rules *SpacingRules) checkPropertyLines(well *WellModel, location *LocationModel, setback Distance) []SpacingFinding {
+	required := setback.Feet()
+	var findings []SpacingFinding
+	lines := []struct {
+		distance  int64
+		valid     bool
+		direction string
+	}{
+		{location.DistanceToPropertyLine1.Int64, location.DistanceToPropertyLine1.Valid, location.DistanceToPropertyLine1Type.String},
+		{location.DistanceToPropertyLine2.Int64, location.DistanceToPropertyLine2.Valid, location.DistanceToPropertyLine2Type.String},
+	}
+	for i, line := range lines {
+		if !line.valid {
+			continue
+		}
+		name := fmt.Sprintf("property line %d", i+1)
+		if line.direction != "" {
+			name = fmt.Sprintf("%s (%s)", name, line.direction)
+		}
+		actual := float64(line.distance)
+		findings = append(findings, SpacingFinding{
+			Rule:     SpacingRulePropertyLine,
+			Result:   passOrFail(actual >= required),
+			Required: required,
+			Actual:   actual,
+			Message:  fmt.Sprintf("%s is %.0f ft away, %.0f ft required", name, actual, required),
+		})
+	}
+	if len(findings) > 0 {
+		return findings
+	}
+
+	finding := SpacingFinding{Rule: SpacingRulePropertyLine, Required: required}
+	if well.CertifiedDistPropertyLine {
+		finding.Result = SpacingPass
+		finding.Message = "distance to property lines certified by applicant"
+	} else {
+		finding.Result = SpacingMissingData
+		finding.Message = "distance to property lines is missing"
+	}
+	return []SpacingFinding{finding}
+}
+
+// checkWellSpacing checks the distance to the nearest well on the property and to each neighbor, reporting neighbors
+// without coordinates as missing data
+func (rules *SpacingRules) checkWellSpacing(well *WellModel, location *LocationModel, neighbors []*WellModel,
+	spacing Distance) []SpacingFinding {
+
+	required := spacing.Feet()
+	var findings []SpacingFinding
+	if location.DistNearestWellOnProperty.Valid {
+		actual := location.DistNearestWellOnProperty.Float64
+		findings = append(findings, SpacingFinding{
+			Rule:     SpacingRuleWellOnProperty,
+			Result:   passOrFail(actual >= required),
+			Required: required,
+			Actual:   actual,
+			Message:  fmt.Sprintf("nearest well on property is %.0f ft away, %.0f ft required", actual, required),
+		})
+	}
+
+	point, hasPoint := well.Point()
+	checkedNeighbors := 0
+	for _, neighbor := range neighbors {
+		if neighbor == nil || neighbor == well ||
+			(neighbor.DefaultModelBase != nil && well.DefaultModelBase != nil && neighbor.ID == well.ID && well.ID != 0) {
+			continue
+		}
+		if !hasPoint {
+			continue
+		}
+		checkedNeighbors++
+		finding := SpacingFinding{Rule: SpacingRuleNeighboringWell, Required: required}
+		if neighbor.DefaultModelBase != nil {
+			finding.NeighborID = neighbor.ID
+		}
+		neighborPoint, ok := neighbor.Point()
+		if !ok {
+			finding.Result = SpacingMissingData
+			finding.Message = fmt.Sprintf("well %d coordinates are missing", finding.NeighborID)
+			findings = append(findings, finding)
+			continue
+		}
+		finding.Actual = Haversine(point, neighborPoint).Feet()
+		finding.Result = passOrFail(finding.Actual >= required)
+		finding.Message = fmt.Sprintf("well %d is %.0f ft away, %.0f ft required", finding.NeighborID, finding.Actual,
+			required)
+		findings = append(findings, finding)
+	}
+
+	if !hasPoint && len(neighbors) > 0 {
+		finding := SpacingFinding{Rule: SpacingRuleExistingWaterWell, Required: required}
+		if well.CertifiedDistExistingWaterWell {
+			finding.Result = SpacingPass
+			finding.Message = "distance to existing water wells certified by applicant"
+		} else {
+			finding.Result = SpacingMissingData
+			finding.Message = "well coordinates are missing"
+		}
+		findings = append(findings, finding)
+	} else if checkedNeighbors == 0 && len(findings) == 0 && well.CertifiedDistExistingWaterWell {
+		findings = append(findings, SpacingFinding{
+			Rule:     SpacingRuleExistingWaterWell,
+			Result:   SpacingPass,
+			Required: required,
+			Message:  "distance to existing water wells certified by applicant",
+		})
+	}
+	return findings
+}
+
+// requiredDistance returns the distance required by the most restrictive tier the well meets.  Wells without casing
+// size or production data are held to the most restrictive tier
+func (rules *SpacingRules) requiredDistance(tiers []SpacingTier, well *WellModel) (Distance, bool) {
+	if len(tiers) == 0 {
+		return 0, false
+	}
+	casingSize, production := -1.0, int64(-1)
+	if well.Construction != nil {
+		if well.Construction.CasingSize.Valid {
+			casingSize = well.Construction.CasingSize.Float64
+		}
+		if well.Construction.MaxPumpProduction.Valid {
+			production = well.Construction.MaxPumpProduction.Int64
+		}
+	}
+
+	var required Distance
+	for _, tier := range tiers {
+		meetsCasing := tier.MinCasingSize <= 0 || casingSize < 0 || casingSize >= tier.MinCasingSize
+		meetsProduction := tier.MinProduction <= 0 || production < 0 || production >= tier.MinProduction
+		if meetsCasing && meetsProduction && tier.Distance > required {
+			required = tier.Distance
+		}
+	}
+	return required, required > 0
+}
+
+// passOrFail converts a check outcome into a result
+func passOrFail(passed bool) SpacingResult {
+	if passed {
+		return SpacingPass
+	}
+	return SpacingFail
+}
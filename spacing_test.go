@@ -0,0 +1,101 @@
+package hydros
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+	"testing"
+)
+
+// testSpacingRules 10 acre tracts, 50/100 ft property line setbacks and 300/1000 ft well spacing by production
+var testSpacingRules = &SpacingRules{
+	MinTractAcres: 10,
+	PropertyLineSetbacks: []SpacingTier{
+		{Distance: Feet(50)},
+		{MinProduction: 17, Distance: Feet(100)},
+	},
+	WellSpacing: []SpacingTier{
+		{Distance: Feet(300)},
+		{MinProduction: 17, Distance: Feet(1000)},
+	},
+}
+
+func spacingWell(id uint, latitude float64, production int64) *WellModel {
+	return &WellModel{
+		DefaultModelBase: &DefaultModelBase{ID: id},
+		Location: &LocationModel{
+			Latitude:  null.FloatFrom(latitude),
+			Longitude: null.FloatFrom(-100.8),
+		},
+		Construction: &ConstructionModel{MaxPumpProduction: null.IntFrom(production)},
+	}
+}
+
+func TestSpacingRules_Evaluate(t *testing.T) {
+
+	well := spacingWell(1, 36.2, 10)
+	well.Location.ContinuousAcredTotal = null.FloatFrom(12.5)
+	well.Location.DistanceToPropertyLine1 = null.IntFrom(75)
+	well.Location.DistanceToPropertyLine1Type = null.StringFrom("North")
+	well.Location.DistanceToPropertyLine2 = null.IntFrom(40)
+
+	// ~364 ft and ~729 ft north
+	neighbors := []*WellModel{well, spacingWell(2, 36.201, 10), spacingWell(3, 36.202, 10)}
+
+	report := testSpacingRules.Evaluate(well, neighbors)
+	assert.Equal(t, uint(1), report.WellID)
+	assert.False(t, report.Passed())
+	if assert.Len(t, report.Findings, 5) {
+		assert.Equal(t, SpacingRuleMinTractSize, report.Findings[0].Rule)
+		assert.Equal(t, SpacingPass, report.Findings[0].Result)
+		assert.Equal(t, SpacingPass, report.Findings[1].Result)
+		assert.Equal(t, "property line 1 (North) is 75 ft away, 50 ft required", report.Findings[1].Message)
+		assert.Equal(t, SpacingFail, report.Findings[2].Result)
+		assert.Equal(t, SpacingRuleNeighboringWell, report.Findings[3].Rule)
+		assert.Equal(t, uint(2), report.Findings[3].NeighborID)
+		assert.Equal(t, SpacingPass, report.Findings[3].Result)
+		assert.Equal(t, SpacingPass, report.Findings[4].Result)
+	}
+	assert.Len(t, report.Failures(), 1)
+
+	// Higher production moves the well into the stricter tier
+	well.Construction.MaxPumpProduction = null.IntFrom(25)
+	report = testSpacingRules.Evaluate(well, neighbors)
+	assert.Len(t, report.Failures(), 4)
+	assert.Equal(t, Feet(1000), testSpacingRules.MaxWellSpacing())
+}
+
+func TestSpacingRules_MissingData(t *testing.T) {
+
+	well := &WellModel{DefaultModelBase: &DefaultModelBase{ID: 1}}
+	report := testSpacingRules.Evaluate(well, []*WellModel{spacingWell(2, 36.2, 10)})
+	if assert.Len(t, report.Findings, 3) {
+		for _, finding := range report.Findings {
+			assert.Equal(t, SpacingMissingData, finding.Result)
+		}
+		// Missing production holds the well to the strictest tier
+		assert.InDelta(t, 100, report.Findings[1].Required, 1e-9)
+		assert.InDelta(t, 1000, report.Findings[2].Required, 1e-9)
+	}
+
+	well.CertifiedMinTractSize = true
+	well.CertifiedDistPropertyLine = true
+	well.CertifiedDistExistingWaterWell = true
+	report = testSpacingRules.Evaluate(well, []*WellModel{spacingWell(2, 36.2, 10)})
+	assert.True(t, report.Passed(), "Certifications should stand in for missing data")
+}
+
+func TestSpacingRules_NeighborMissingCoordinates(t *testing.T) {
+
+	well := spacingWell(1, 36.2, 10)
+	neighbor := &WellModel{DefaultModelBase: &DefaultModelBase{ID: 2}}
+	rules := &SpacingRules{WellSpacing: []SpacingTier{{Distance: Feet(300)}}}
+
+	report := rules.Evaluate(well, []*WellModel{neighbor})
+	assert.False(t, report.Passed(), "A neighbor without coordinates should not pass")
+	if assert.Len(t, report.Findings, 1) {
+		assert.Equal(t, SpacingRuleNeighboringWell, report.Findings[0].Rule)
+		assert.Equal(t, SpacingMissingData, report.Findings[0].Result)
+		assert.Equal(t, uint(2), report.Findings[0].NeighborID)
+		assert.Equal(t, "well 2 coordinates are missing", report.Findings[0].Message)
+	}
+}
@@ -0,0 +1,400 @@
+package hydros
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TableValueSeparator joins the values of a column that passes through a list, e.g. "wellUses.wellUse"
+const TableValueSeparator = "; "
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	modelBaseType     = reflect.TypeOf(DefaultModelBase{})
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// TableColumns returns the dotted columns exported by default for records like model (e.g. WellModel{}).  Nested
+// structs are flattened, so a well's county becomes "location.county".  Lists of models such as a well's contacts
+// only contribute their ID column; select other list columns, e.g. "contacts.email", explicitly
+func TableColumns(model interface{}) []string {
+	return flattenColumns(reflect.TypeOf(model), "", make(map[reflect.Type]bool))
+}
+
+// CSVEncoder streams records such as *WellModel, *MeterModel or *MeterReadingModel to CSV.  Null values are written
+// as blank cells.  Close must be called to flush output
+type CSVEncoder struct {
+	table *tableEncoder
+}
+
+// NewCSVEncoder creates an encoder writing the given dotted columns to w, in order.  No columns selects
+// TableColumns of the first record
+func NewCSVEncoder(w io.Writer, columns ...string) *CSVEncoder {
+	return &CSVEncoder{table: &tableEncoder{rows: &csvRowWriter{writer: csv.NewWriter(w)}, columns: columns}}
+}
+
+// Encode writes one row per record.  All records must be of the same type and nil records are rejected
+func (encoder *CSVEncoder) Encode(records ...interface{}) error {
+	return encoder.table.encode(records)
+}
+
+// Close flushes buffered output.  It does not close the underlying writer
+func (encoder *CSVEncoder) Close() error {
+	return encoder.table.close()
+}
+
+// XLSXEncoder streams records to a single sheet XLSX workbook.  Close must be called to finish the workbook
+type XLSXEncoder struct {
+	table *tableEncoder
+}
+
+// NewXLSXEncoder creates an encoder writing the given dotted columns to a sheet named sheetName.  No columns selects
+// TableColumns of the first record
+func NewXLSXEncoder(w io.Writer, sheetName string, columns ...string) *XLSXEncoder {
+	return &XLSXEncoder{table: &tableEncoder{rows: newXLSXWriter(w, sheetName), columns: columns}}
+}
+
+// Encode writes one row per record.  All records must be of the same type and nil records are rejected
+func (encoder *XLSXEncoder) Encode(records ...interface{}) error {
+	return encoder.table.encode(records)
+}
+
+// Close finishes the workbook.  It does not close the underlying writer
+func (encoder *XLSXEncoder) Close() error {
+	return encoder.table.close()
+}
+
+// rowWriter writes rows of cells in a table format
+type rowWriter interface {
+	writeRow(cells []string) error
+	close() error
+}
+
+// tableEncoder flattens records into rows with a header of columns
+type tableEncoder struct {
+	rows       rowWriter
+	columns    []string
+	recordType reflect.Type
+	closed     bool
+}
+
+// encode writes the header before the first record, then a row per record
+func (encoder *tableEncoder) encode(records []interface{}) error {
+	if encoder.closed {
+		return errEncoderClosed
+	}
+	for _, record := range records {
+		value := reflect.ValueOf(record)
+		if !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil()) {
+			return errors.New("cannot encode nil record")
+		}
+		recordType := value.Type()
+		for recordType.Kind() == reflect.Ptr {
+			recordType = recordType.Elem()
+		}
+		if encoder.recordType == nil {
+			if err := encoder.start(recordType); err != nil {
+				return err
+			}
+		} else if recordType != encoder.recordType {
+			return fmt.Errorf("cannot encode %s records into a %s table", recordType.Name(), encoder.recordType.Name())
+		}
+
+		cells := make([]string, len(encoder.columns))
+		for i, column := range encoder.columns {
+			cells[i] = strings.Join(columnValues(value, strings.Split(column, ".")), TableValueSeparator)
+		}
+		if err := encoder.rows.writeRow(cells); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// start validates the columns against the record type and writes the header
+func (encoder *tableEncoder) start(recordType reflect.Type) error {
+	if recordType.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot encode %s records into a table", recordType.Kind())
+	}
+	if len(encoder.columns) == 0 {
+		encoder.columns = flattenColumns(recordType, "", make(map[reflect.Type]bool))
+	}
+	for _, column := range encoder.columns {
+		if !columnExists(recordType, strings.Split(column, ".")) {
+			return fmt.Errorf("unknown %s column '%s'", recordType.Name(), column)
+		}
+	}
+	encoder.recordType = recordType
+	return encoder.rows.writeRow(encoder.columns)
+}
+
+// close writes a header-only table when no records were encoded, then closes the writer
+func (encoder *tableEncoder) close() error {
+	if encoder.closed {
+		return nil
+	}
+	encoder.closed = true
+	if encoder.recordType == nil && len(encoder.columns) > 0 {
+		if err := encoder.rows.writeRow(encoder.columns); err != nil {
+			return err
+		}
+	}
+	return encoder.rows.close()
+}
+
+// csvRowWriter writes rows as CSV
+type csvRowWriter struct {
+	writer *csv.Writer
+}
+
+func (rows *csvRowWriter) writeRow(cells []string) error {
+	return rows.writer.Write(cells)
+}
+
+func (rows *csvRowWriter) close() error {
+	rows.writer.Flush()
+	return rows.writer.Error()
+}
+
+// jsonField field of a struct as encoded to JSON
+type jsonField struct {
+	name  string
+	index []int
+	typ   reflect.Type
+}
+
+// jsonFields lists the JSON encoded fields of structType in declaration order, promoting fields of embedded structs
+// unless an outer field has the same name
+func jsonFields(structType reflect.Type) []jsonField {
+	outer := make(map[string]bool)
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			continue
+		}
+		if name, ok := jsonFieldName(field); ok {
+			outer[name] = true
+		}
+	}
+
+	var fields []jsonField
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			embeddedType := field.Type
+			if embeddedType.Kind() == reflect.Ptr {
+				embeddedType = embeddedType.Elem()
+			}
+			if embeddedType.Kind() != reflect.Struct {
+				continue
+			}
+			for _, promoted := range jsonFields(embeddedType) {
+				if !outer[promoted.name] {
+					promoted.index = append([]int{i}, promoted.index...)
+					fields = append(fields, promoted)
+				}
+			}
+			continue
+		}
+		name, ok := jsonFieldName(field)
+		if !ok || field.Type.Kind() == reflect.Func || field.Type.Kind() == reflect.Chan {
+			continue
+		}
+		fields = append(fields, jsonField{name: name, index: []int{i}, typ: field.Type})
+	}
+	return fields
+}
+
+// isTableScalar reports whether values of fieldType fill a single cell
+func isTableScalar(fieldType reflect.Type) bool {
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Implements(jsonMarshalerType) || reflect.PtrTo(fieldType).Implements(jsonMarshalerType) {
+		return true
+	}
+	switch fieldType.Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Array:
+		return false
+	}
+	return true
+}
+
+// tableElemType strips pointers and lists from fieldType until a scalar or struct remains
+func tableElemType(fieldType reflect.Type) reflect.Type {
+	for !isTableScalar(fieldType) {
+		switch fieldType.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			fieldType = fieldType.Elem()
+		default:
+			return fieldType
+		}
+	}
+	return fieldType
+}
+
+// isModelList reports whether fieldType is a list of models embedding DefaultModelBase
+func isModelList(fieldType reflect.Type) bool {
+	if fieldType.Kind() != reflect.Slice {
+		return false
+	}
+	elemType := tableElemType(fieldType)
+	if elemType.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < elemType.NumField(); i++ {
+		field := elemType.Field(i)
+		if field.Anonymous && (field.Type == modelBaseType || field.Type == reflect.PtrTo(modelBaseType)) {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenColumns lists the dotted columns of fieldType below prefix.  stack guards against recursive types
+func flattenColumns(fieldType reflect.Type, prefix string, stack map[reflect.Type]bool) []string {
+	elemType := tableElemType(fieldType)
+	if isTableScalar(elemType) || elemType.Kind() != reflect.Struct {
+		return []string{prefix}
+	}
+	if stack[elemType] {
+		return nil
+	}
+	stack[elemType] = true
+	defer delete(stack, elemType)
+
+	var columns []string
+	for _, field := range jsonFields(elemType) {
+		name := field.name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		if isModelList(field.typ) {
+			columns = append(columns, name+".id")
+			continue
+		}
+		columns = append(columns, flattenColumns(field.typ, name, stack)...)
+	}
+	return columns
+}
+
+// columnExists reports whether the dotted path resolves against fieldType
+func columnExists(fieldType reflect.Type, path []string) bool {
+	elemType := tableElemType(fieldType)
+	if len(path) == 0 {
+		return true
+	}
+	if isTableScalar(elemType) || elemType.Kind() != reflect.Struct {
+		return false
+	}
+	for _, field := range jsonFields(elemType) {
+		if field.name == path[0] {
+			return columnExists(field.typ, path[1:])
+		}
+	}
+	return false
+}
+
+// columnValues collects the cell values at the dotted path, one per list element the path passes through
+func columnValues(value reflect.Value, path []string) []string {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	if isTableScalar(value.Type()) {
+		if len(path) > 0 {
+			return nil
+		}
+		if cell, ok := formatTableCell(value); ok {
+			return []string{cell}
+		}
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		var values []string
+		for i := 0; i < value.Len(); i++ {
+			values = append(values, columnValues(value.Index(i), path)...)
+		}
+		return values
+	case reflect.Struct:
+		if len(path) == 0 {
+			encoded, err := json.Marshal(value.Interface())
+			if err != nil {
+				return nil
+			}
+			return []string{string(encoded)}
+		}
+		for _, field := range jsonFields(value.Type()) {
+			if field.name != path[0] {
+				continue
+			}
+			fieldValue, ok := fieldByIndex(value, field.index)
+			if !ok {
+				return nil
+			}
+			return columnValues(fieldValue, path[1:])
+		}
+	}
+	return nil
+}
+
+// fieldByIndex is reflect.Value.FieldByIndex that reports false instead of panicking on nil embedded pointers
+func fieldByIndex(value reflect.Value, index []int) (reflect.Value, bool) {
+	for i, fieldIndex := range index {
+		if i > 0 {
+			for value.Kind() == reflect.Ptr {
+				if value.IsNil() {
+					return reflect.Value{}, false
+				}
+				value = value.Elem()
+			}
+		}
+		value = value.Field(fieldIndex)
+	}
+	return value, true
+}
+
+// formatTableCell formats a scalar value, reporting false for null values
+func formatTableCell(value reflect.Value) (string, bool) {
+	if value.Type() == timeType {
+		if value.Interface().(time.Time).IsZero() {
+			return "", false
+		}
+		return value.Interface().(time.Time).Format(time.RFC3339), true
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64), true
+	}
+
+	encoded, err := json.Marshal(value.Interface())
+	if err != nil || string(encoded) == "null" {
+		return "", false
+	}
+	var text string
+	if err := json.Unmarshal(encoded, &text); err == nil {
+		return text, true
+	}
+	return string(encoded), true
+}
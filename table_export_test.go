@@ -0,0 +1,129 @@
+package hydros
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestTableColumns(t *testing.T) {
+
+	columns := TableColumns(WellModel{})
+	assert.Equal(t, "id", columns[0])
+	assert.Contains(t, columns, "serial")
+	assert.Contains(t, columns, "location.county")
+	assert.Contains(t, columns, "construction.screens.topDepth")
+	assert.Contains(t, columns, "owner.firstName")
+	assert.Contains(t, columns, "wellUses.wellUse")
+	assert.Contains(t, columns, "contacts.id")
+	assert.NotContains(t, columns, "contacts.firstName")
+	assert.NotContains(t, columns, "snapshot")
+
+	createdAt := 0
+	for _, column := range columns {
+		if column == "createdAt" {
+			createdAt++
+		}
+	}
+	assert.Equal(t, 1, createdAt, "Promoted fields should not shadow outer fields")
+
+	assert.Contains(t, TableColumns(MeterModel{}), "wells.id")
+	assert.Contains(t, TableColumns(MeterReadingModel{}), "extraData")
+}
+
+func TestCSVEncoder(t *testing.T) {
+
+	drillingDate := time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)
+	wells := []*WellModel{
+		{
+			DefaultModelBase: &DefaultModelBase{ID: 1},
+			Serial:           "W-1",
+			Name:             null.StringFrom("Smith, \"Sr.\""),
+			Location:         &LocationModel{County: null.StringFrom("Ochiltree"), Elevation: null.FloatFrom(2890.5)},
+			DrillingDate:     null.TimeFrom(drillingDate),
+			Contacts:         []*ContactModel{{Email: null.StringFrom("a@b.c")}, {Email: null.StringFrom("d@e.f")}},
+			WellUses:         []WellUse{{WellUse: "Irrigation"}, {WellUse: "Livestock"}},
+		},
+		{DefaultModelBase: &DefaultModelBase{ID: 2}, Serial: "W-2"},
+	}
+
+	var buf bytes.Buffer
+	encoder := NewCSVEncoder(&buf, "id", "serial", "name", "location.county", "location.elevation", "drillingDate",
+		"contacts.email", "wellUses.wellUse")
+	assert.Nil(t, encoder.Encode(wells[0], wells[1]))
+	assert.Nil(t, encoder.Close())
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, [][]string{
+		{"id", "serial", "name", "location.county", "location.elevation", "drillingDate", "contacts.email", "wellUses.wellUse"},
+		{"1", "W-1", "Smith, \"Sr.\"", "Ochiltree", "2890.5", "2019-04-01T00:00:00Z", "a@b.c; d@e.f", "Irrigation; Livestock"},
+		{"2", "W-2", "", "", "", "", "", ""},
+	}, rows)
+}
+
+func TestCSVEncoder_Errors(t *testing.T) {
+
+	var buf bytes.Buffer
+	encoder := NewCSVEncoder(&buf, "location.nope")
+	assert.EqualError(t, encoder.Encode(&WellModel{}), "unknown WellModel column 'location.nope'")
+
+	// Typed nil records are rejected before they can start a table
+	buf.Reset()
+	encoder = NewCSVEncoder(&buf)
+	assert.EqualError(t, encoder.Encode((*WellModel)(nil)), "cannot encode nil record")
+	assert.Nil(t, encoder.Close())
+	assert.Equal(t, "", buf.String())
+
+	encoder = NewCSVEncoder(&buf)
+	assert.Nil(t, encoder.Encode(&MeterModel{DefaultModelBase: &DefaultModelBase{ID: 1}}))
+	assert.EqualError(t, encoder.Encode(&WellModel{}), "cannot encode WellModel records into a MeterModel table")
+	assert.EqualError(t, encoder.Encode(nil), "cannot encode nil record")
+	assert.EqualError(t, encoder.Encode((*MeterModel)(nil)), "cannot encode nil record")
+}
+
+func TestXLSXEncoder(t *testing.T) {
+
+	var buf bytes.Buffer
+	encoder := NewXLSXEncoder(&buf, "Readings", "id", "reading", "notes")
+	assert.Nil(t, encoder.Encode(
+		&MeterReadingModel{DefaultModelBase: &DefaultModelBase{ID: 7}, Reading: 1250.25, Notes: null.StringFrom("<estimated>")},
+		MeterReadingModel{DefaultModelBase: &DefaultModelBase{ID: 8}, Reading: 1300}))
+	assert.Nil(t, encoder.Close())
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Nil(t, err, "Workbook should be a valid zip archive")
+	parts := make(map[string][]byte)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		assert.Nil(t, err, "Error should be nil.")
+		parts[file.Name], _ = ioutil.ReadAll(reader)
+		_ = reader.Close()
+	}
+	assert.Contains(t, parts, "[Content_Types].xml")
+	assert.Contains(t, string(parts["xl/workbook.xml"]), `<sheet name="Readings"`)
+
+	var sheet struct {
+		Rows []struct {
+			Cells []struct {
+				Ref  string `xml:"r,attr"`
+				Text string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	assert.Nil(t, xml.Unmarshal(parts["xl/worksheets/sheet1.xml"], &sheet), "Sheet should be well-formed")
+	if assert.Len(t, sheet.Rows, 3) {
+		assert.Equal(t, "A1", sheet.Rows[0].Cells[0].Ref)
+		assert.Equal(t, "C2", sheet.Rows[1].Cells[2].Ref)
+		assert.Equal(t, "<estimated>", sheet.Rows[1].Cells[2].Text)
+		assert.Equal(t, "1300", sheet.Rows[2].Cells[1].Text)
+		assert.Len(t, sheet.Rows[2].Cells, 2, "Blank cells should be left out")
+	}
+	assert.Equal(t, "AB", xlsxColumnName(27))
+}
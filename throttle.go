@@ -0,0 +1,181 @@
+package hydros
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ThrottleStats snapshot of the client's outgoing traffic shaping
+type ThrottleStats struct {
+	// InFlight number of requests currently being sent
+	InFlight int
+	// Waiting number of requests blocked on the rate limit or concurrency cap
+	Waiting int
+	// LastWait time the most recent request spent waiting before being sent
+	LastWait time.Duration
+	// EstimatedWait time a request made now would wait for a rate limit token
+	EstimatedWait time.Duration
+}
+
+// SetRateLimit limits outgoing requests using a token bucket refilled at requestsPerSecond and holding up to
+// burst tokens.  Requests block until a token is available or their context is done
+func SetRateLimit(requestsPerSecond float64, burst int) ClientOptionFunc {
+	return func(c *Client) error {
+		if requestsPerSecond <= 0 {
+			return errors.New("rate limit must be greater than 0 requests per second")
+		}
+		if burst < 1 {
+			burst = 1
+		}
+		throttle := c.getThrottle()
+		throttle.rate = requestsPerSecond
+		throttle.burst = float64(burst)
+		throttle.tokens = float64(burst)
+		return nil
+	}
+}
+
+// SetMaxConcurrency caps the number of requests in flight at once.  Additional requests block until a slot
+// frees up or their context is done
+func SetMaxConcurrency(maxInFlight int) ClientOptionFunc {
+	return func(c *Client) error {
+		if maxInFlight < 1 {
+			return errors.New("max concurrency must be at least 1")
+		}
+		c.getThrottle().slots = make(chan struct{}, maxInFlight)
+		return nil
+	}
+}
+
+// ThrottleStats returns current wait and in-flight figures so callers can back off before being blocked
+func (client *Client) ThrottleStats() ThrottleStats {
+	if client.throttle == nil {
+		return ThrottleStats{}
+	}
+	return client.throttle.stats(time.Now())
+}
+
+// getThrottle returns the client's throttle, creating it on first use
+func (client *Client) getThrottle() *throttle {
+	if client.throttle == nil {
+		client.throttle = &throttle{}
+	}
+	return client.throttle
+}
+
+// throttle token bucket rate limiter combined with a semaphore for in-flight requests
+type throttle struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	slots    chan struct{}
+	inFlight int
+	waiting  int
+	lastWait time.Duration
+}
+
+// acquire blocks until the request may be sent.  The returned func must be called once the request completes
+func (t *throttle) acquire(ctx context.Context) (func(), error) {
+	start := time.Now()
+	t.mu.Lock()
+	t.waiting++
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		t.waiting--
+		t.mu.Unlock()
+	}()
+
+	if t.rate > 0 {
+		if wait := t.reserve(start); wait > 0 {
+			if err := sleepContext(ctx, wait); err != nil {
+				t.unreserve()
+				return nil, err
+			}
+		}
+	}
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			if t.rate > 0 {
+				t.unreserve()
+			}
+			return nil, ctx.Err()
+		}
+	}
+
+	t.mu.Lock()
+	t.inFlight++
+	t.lastWait = time.Since(start)
+	t.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.mu.Lock()
+			t.inFlight--
+			t.mu.Unlock()
+			if t.slots != nil {
+				<-t.slots
+			}
+		})
+	}, nil
+}
+
+// reserve takes a token and returns how long the caller must wait before it is usable
+func (t *throttle) reserve(now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.refill(now)
+	t.tokens--
+	if t.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-t.tokens / t.rate * float64(time.Second))
+}
+
+// unreserve returns a token taken by a request that gave up waiting
+func (t *throttle) unreserve() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tokens++
+}
+
+// refill adds tokens accrued since the last refill.  Caller must hold the lock
+func (t *throttle) refill(now time.Time) {
+	if t.last.IsZero() {
+		t.last = now
+		return
+	}
+	if now.After(t.last) {
+		t.tokens += now.Sub(t.last).Seconds() * t.rate
+		if t.tokens > t.burst {
+			t.tokens = t.burst
+		}
+		t.last = now
+	}
+}
+
+// stats snapshot of the throttle state at now
+func (t *throttle) stats(now time.Time) ThrottleStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stats := ThrottleStats{
+		InFlight: t.inFlight,
+		Waiting:  t.waiting,
+		LastWait: t.lastWait,
+	}
+	if t.rate > 0 {
+		t.refill(now)
+		if t.tokens < 1 {
+			stats.EstimatedWait = time.Duration((1 - t.tokens) / t.rate * float64(time.Second))
+		}
+	}
+	return stats
+}
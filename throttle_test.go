@@ -0,0 +1,123 @@
+package hydros
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSetRateLimit(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL), SetRateLimit(20, 2))
+	assert.Nil(t, err, "Error should be nil.")
+
+	// Burst of 2 goes through immediately, the following 2 wait ~50ms each
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err := client.Well.Get(1)
+		assert.Nil(t, err, "Error should be nil.")
+	}
+	elapsed := time.Since(start)
+	assert.True(t, elapsed >= 90*time.Millisecond, "Requests should have been rate limited, took %s", elapsed)
+	assert.True(t, client.ThrottleStats().LastWait > 0, "Last wait should be recorded")
+}
+
+func TestSetRateLimit_ContextCancel(t *testing.T) {
+
+	client, err := NewClient(SetHost("https://api.somewhere.com"), SetRateLimit(0.1, 1))
+	assert.Nil(t, err, "Error should be nil.")
+
+	// Drain the only token
+	release, err := client.throttle.acquire(context.Background())
+	assert.Nil(t, err, "Error should be nil.")
+	release()
+	assert.True(t, client.ThrottleStats().EstimatedWait > 9*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.Well.GetContext(ctx, 1)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestThrottle_SlotWaitCancelReturnsToken(t *testing.T) {
+
+	client, err := NewClient(SetRateLimit(0.1, 2), SetMaxConcurrency(1))
+	assert.Nil(t, err, "Error should be nil.")
+
+	release, err := client.throttle.acquire(context.Background())
+	assert.Nil(t, err, "Error should be nil.")
+
+	// Gives up waiting for the only slot after taking the second token
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.throttle.acquire(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	release()
+
+	// The returned token lets the next request through without waiting on the rate limit
+	release, err = client.throttle.acquire(context.Background())
+	assert.Nil(t, err, "Error should be nil.")
+	release()
+	assert.True(t, client.ThrottleStats().LastWait < time.Second, "Token should have been returned")
+}
+
+func TestSetMaxConcurrency(t *testing.T) {
+
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL), SetMaxConcurrency(2))
+	assert.Nil(t, err, "Error should be nil.")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Well.Get(1)
+			assert.Nil(t, err, "Error should be nil.")
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+	assert.Equal(t, 0, client.ThrottleStats().InFlight)
+	assert.Equal(t, 0, client.ThrottleStats().Waiting)
+}
+
+func TestThrottleOptions_Invalid(t *testing.T) {
+
+	client, err := NewClient(SetRateLimit(0, 1))
+	assert.Nil(t, client, "Client should be nil")
+	assert.NotNil(t, err, "Error should not be nil.")
+
+	client, err = NewClient(SetMaxConcurrency(0))
+	assert.Nil(t, client, "Client should be nil")
+	assert.NotNil(t, err, "Error should not be nil.")
+
+	client, err = NewClient()
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, ThrottleStats{}, client.ThrottleStats())
+}
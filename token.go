@@ -0,0 +1,285 @@
+package hydros
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTokenRefreshBefore how long before expiry a cached token is proactively refreshed
+const DefaultTokenRefreshBefore = 30 * time.Second
+
+// TokenFetchTimeout limit on a shared token refresh, which runs independently of the contexts of waiting callers
+const TokenFetchTimeout = time.Minute
+
+// Token access token issued by an OAuth2/OpenID provider
+type Token struct {
+	AccessToken  string
+	TokenType    string
+	RefreshToken string
+	// Expiry zero value means the token does not expire
+	Expiry time.Time
+}
+
+// Type returns the token type used in the Authorization header, defaulting to Bearer
+func (token *Token) Type() string {
+	if token.TokenType == "" || strings.EqualFold(token.TokenType, "bearer") {
+		return "Bearer"
+	}
+	return token.TokenType
+}
+
+// Valid reports whether the token is set and not expired
+func (token *Token) Valid() bool {
+	return token != nil && token.AccessToken != "" && !token.expiresWithin(0, time.Now())
+}
+
+// expiresWithin reports whether the token expires within d of now
+func (token *Token) expiresWithin(d time.Duration, now time.Time) bool {
+	if token.Expiry.IsZero() {
+		return false
+	}
+	return !now.Add(d).Before(token.Expiry)
+}
+
+// TokenSource supplies access tokens for authenticating requests
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// SetTokenSource authenticates requests with tokens from source.  Tokens are cached, refreshed
+// DefaultTokenRefreshBefore their expiry and refreshed once more if the API responds 401 Unauthorized
+func SetTokenSource(source TokenSource) ClientOptionFunc {
+	return func(c *Client) error {
+		if source == nil {
+			return errors.New("token source must not be nil")
+		}
+		c.AuthType = AuthTypeOpenID
+		c.TokenSource = ReuseTokenSource(source, DefaultTokenRefreshBefore)
+		return nil
+	}
+}
+
+// StaticTokenSource returns a TokenSource that always returns the same non-expiring access token
+func StaticTokenSource(accessToken string) TokenSource {
+	return &staticTokenSource{token: &Token{AccessToken: accessToken}}
+}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+// Token returns the static token
+func (source *staticTokenSource) Token(ctx context.Context) (*Token, error) {
+	return source.token, nil
+}
+
+// ReuseTokenSource returns a TokenSource caching tokens from source until refreshBefore their expiry.  Concurrent
+// callers share a single in-flight refresh
+func ReuseTokenSource(source TokenSource, refreshBefore time.Duration) TokenSource {
+	if reuse, ok := source.(*reuseTokenSource); ok {
+		source = reuse.source
+	}
+	return &reuseTokenSource{source: source, refreshBefore: refreshBefore}
+}
+
+type reuseTokenSource struct {
+	source        TokenSource
+	refreshBefore time.Duration
+
+	mu       sync.Mutex
+	token    *Token
+	inFlight *tokenCall
+}
+
+// tokenCall in-flight refresh shared by concurrent callers
+type tokenCall struct {
+	done  chan struct{}
+	token *Token
+	err   error
+}
+
+// Token returns the cached token or fetches a new one when it is missing or about to expire
+func (source *reuseTokenSource) Token(ctx context.Context) (*Token, error) {
+	source.mu.Lock()
+	if source.token != nil && !source.token.expiresWithin(source.refreshBefore, time.Now()) {
+		token := source.token
+		source.mu.Unlock()
+		return token, nil
+	}
+
+	call := source.inFlight
+	if call == nil {
+		call = &tokenCall{done: make(chan struct{})}
+		source.inFlight = call
+		go source.fetch(call)
+	}
+	source.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetch refreshes the token for call on a context no single caller can cancel
+func (source *reuseTokenSource) fetch(call *tokenCall) {
+	ctx, cancel := context.WithTimeout(context.Background(), TokenFetchTimeout)
+	defer cancel()
+
+	call.token, call.err = source.source.Token(ctx)
+	if call.err == nil && call.token == nil {
+		call.err = errors.New("token source returned no token")
+	}
+
+	source.mu.Lock()
+	if call.err == nil {
+		source.token = call.token
+	}
+	source.inFlight = nil
+	source.mu.Unlock()
+	close(call.done)
+}
+
+// invalidate drops the cached token if it is still the given rejected token
+func (source *reuseTokenSource) invalidate(rejected *Token) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	if source.token == rejected {
+		source.token = nil
+	}
+}
+
+// ClientCredentialsTokenSource fetches tokens using the OAuth2 client credentials grant
+type ClientCredentialsTokenSource struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// HTTPClient optional client used for token requests
+	HTTPClient *http.Client
+}
+
+// Token requests a new token from the token endpoint
+func (source *ClientCredentialsTokenSource) Token(ctx context.Context) (*Token, error) {
+	params := url.Values{"grant_type": {"client_credentials"}}
+	if len(source.Scopes) > 0 {
+		params.Set("scope", strings.Join(source.Scopes, " "))
+	}
+	return fetchToken(ctx, source.HTTPClient, source.TokenURL, source.ClientID, source.ClientSecret, params)
+}
+
+// RefreshTokenTokenSource fetches tokens using the OAuth2 refresh token grant.  Rotated refresh tokens returned by
+// the provider replace RefreshToken
+type RefreshTokenTokenSource struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	// HTTPClient optional client used for token requests
+	HTTPClient *http.Client
+
+	mu sync.Mutex
+}
+
+// Token exchanges the refresh token for a new access token
+func (source *RefreshTokenTokenSource) Token(ctx context.Context) (*Token, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	if source.RefreshToken == "" {
+		return nil, errors.New("refresh token source has no refresh token")
+	}
+	params := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {source.RefreshToken},
+	}
+	token, err := fetchToken(ctx, source.HTTPClient, source.TokenURL, source.ClientID, source.ClientSecret, params)
+	if err != nil {
+		return nil, err
+	}
+	if token.RefreshToken != "" {
+		source.RefreshToken = token.RefreshToken
+	}
+	return token, nil
+}
+
+// tokenResponse token endpoint response payload
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// fetchToken posts a token request to tokenURL authenticating the client with HTTP basic auth
+func fetchToken(ctx context.Context, httpClient *http.Client, tokenURL string, clientID string, clientSecret string,
+	params url.Values) (*Token, error) {
+
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if clientID != "" {
+		req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload tokenResponse
+	decodeErr := json.Unmarshal(bodyBytes, &payload)
+	if resp.StatusCode != http.StatusOK {
+		apiError := &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       bodyBytes,
+			Method:     req.Method,
+			URL:        tokenURL,
+			RequestID:  resp.Header.Get("X-Request-Id"),
+		}
+		if decodeErr == nil && payload.Error != "" {
+			apiError.ErrorResponse = &ErrorResponse{Message: payload.Error, Description: payload.ErrorDescription}
+		}
+		return nil, apiError
+	}
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+	if payload.AccessToken == "" {
+		return nil, errors.New("token endpoint response is missing access_token")
+	}
+
+	token := &Token{
+		AccessToken:  payload.AccessToken,
+		TokenType:    payload.TokenType,
+		RefreshToken: payload.RefreshToken,
+	}
+	if payload.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(payload.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
@@ -0,0 +1,184 @@
+package hydros
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingTokenSource struct {
+	calls  int32
+	expiry time.Duration
+	delay  time.Duration
+}
+
+func (source *countingTokenSource) Token(ctx context.Context) (*Token, error) {
+	call := atomic.AddInt32(&source.calls, 1)
+	select {
+	case <-time.After(source.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	token := &Token{AccessToken: "token-" + string(rune('0'+call))}
+	if source.expiry != 0 {
+		token.Expiry = time.Now().Add(source.expiry)
+	}
+	return token, nil
+}
+
+func TestToken_Valid(t *testing.T) {
+
+	var token *Token
+	assert.False(t, token.Valid())
+	assert.True(t, (&Token{AccessToken: "abc"}).Valid())
+	assert.False(t, (&Token{AccessToken: "abc", Expiry: time.Now().Add(-time.Second)}).Valid())
+	assert.Equal(t, "Bearer", (&Token{TokenType: "bearer"}).Type())
+	assert.Equal(t, "MAC", (&Token{TokenType: "MAC"}).Type())
+}
+
+func TestReuseTokenSource_CachesAndRefreshes(t *testing.T) {
+
+	source := &countingTokenSource{expiry: time.Hour}
+	reuse := ReuseTokenSource(source, time.Minute)
+
+	first, err := reuse.Token(context.Background())
+	assert.Nil(t, err, "Error should be nil.")
+	second, err := reuse.Token(context.Background())
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, first, second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&source.calls))
+
+	// Tokens inside the refresh window are proactively refreshed
+	source = &countingTokenSource{expiry: 30 * time.Second}
+	reuse = ReuseTokenSource(source, time.Minute)
+	_, _ = reuse.Token(context.Background())
+	_, _ = reuse.Token(context.Background())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&source.calls))
+}
+
+func TestReuseTokenSource_SingleFlight(t *testing.T) {
+
+	source := &countingTokenSource{expiry: time.Hour, delay: 20 * time.Millisecond}
+	reuse := ReuseTokenSource(source, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := reuse.Token(context.Background())
+			assert.Nil(t, err, "Error should be nil.")
+			assert.Equal(t, "token-1", token.AccessToken)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&source.calls))
+}
+
+func TestReuseTokenSource_FirstCallerCanceled(t *testing.T) {
+
+	source := &countingTokenSource{expiry: time.Hour, delay: 50 * time.Millisecond}
+	reuse := ReuseTokenSource(source, time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := reuse.Token(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	token, err := reuse.Token(context.Background())
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, "token-1", token.AccessToken)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&source.calls))
+}
+
+func TestClientCredentialsTokenSource(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "wells:read wells:write", r.PostForm.Get("scope"))
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "client", user)
+		assert.Equal(t, "secret", pass)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"abc","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	source := &ClientCredentialsTokenSource{
+		TokenURL:     server.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Scopes:       []string{"wells:read", "wells:write"},
+	}
+	token, err := source.Token(context.Background())
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, "abc", token.AccessToken)
+	assert.True(t, token.Expiry.After(time.Now().Add(59*time.Minute)))
+}
+
+func TestRefreshTokenTokenSource(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+		if r.PostForm.Get("refresh_token") != "refresh-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"refresh token revoked"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"abc","refresh_token":"refresh-2","expires_in":60}`))
+	}))
+	defer server.Close()
+
+	source := &RefreshTokenTokenSource{TokenURL: server.URL, ClientID: "client", RefreshToken: "refresh-1"}
+	token, err := source.Token(context.Background())
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, "abc", token.AccessToken)
+	assert.Equal(t, "refresh-2", source.RefreshToken, "Rotated refresh token should be kept")
+
+	_, err = source.Token(context.Background())
+	var apiError *APIError
+	if assert.True(t, errors.As(err, &apiError)) {
+		assert.Equal(t, "invalid_grant: refresh token revoked", apiError.Error())
+	}
+}
+
+func TestSetTokenSource_RetriesOnceAfterUnauthorized(t *testing.T) {
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":5}`))
+	}))
+	defer server.Close()
+
+	source := &countingTokenSource{expiry: time.Hour}
+	client, err := NewClient(SetHost(server.URL), SetTokenSource(source))
+	assert.Nil(t, err, "Error should be nil.")
+
+	well, err := client.Well.Get(5)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, uint(5), well.ID)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&source.calls))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	// A token that keeps being rejected is only refreshed once per call
+	client, err = NewClient(SetHost(server.URL), SetTokenSource(StaticTokenSource("bad")))
+	assert.Nil(t, err, "Error should be nil.")
+	atomic.StoreInt32(&requests, 0)
+	_, err = client.Well.Get(5)
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
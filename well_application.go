@@ -0,0 +1,177 @@
+package hydros
+
+import (
+	"fmt"
+	"gopkg.in/guregu/null.v3"
+	"reflect"
+	"strings"
+)
+
+// ApplicationProfile fields a district requires on a well application
+type ApplicationProfile struct {
+	// RequiredFields dotted JSON fields that must be set, e.g. "location.county".  Lists must not be empty
+	RequiredFields []string
+	// RequiredCertifications boolean certifications and agreements that must be true, e.g. "certifiedRules"
+	RequiredCertifications []string
+	// Conditions fields only required when a boolean field is true
+	Conditions []FieldCondition
+}
+
+// FieldCondition fields required when the boolean field When is true
+type FieldCondition struct {
+	When    string
+	Require []string
+}
+
+// DefaultApplicationProfile location, owner, use and the standard certifications, with transport and exemption
+// details when applicable
+var DefaultApplicationProfile = &ApplicationProfile{
+	RequiredFields: []string{"location.latitude", "location.longitude", "location.county", "owner", "wellUses"},
+	RequiredCertifications: []string{
+		"certifiedBeneficial",
+		"certifiedRules",
+		"districtRulesAgreement",
+		"abideRules",
+		"certifiedInfoCorrect",
+	},
+	Conditions: []FieldCondition{
+		{When: "transportedOutOfGCD", Require: []string{"transportedOutOfGCDDescription", "locationTransported", "estimatedAnnualTransportedGallons"}},
+		{When: "exempt", Require: []string{"exemptionType"}},
+	},
+}
+
+// FieldError validation failure of a single field
+type FieldError struct {
+	// Field dotted JSON field, e.g. "location.county"
+	Field   string
+	Message string
+}
+
+// Error formats the field and message
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s %s", e.Field, e.Message)
+}
+
+// ValidationErrors field errors of an incomplete application
+type ValidationErrors []FieldError
+
+// Error joins the field errors
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("invalid well application: %s", strings.Join(messages, "; "))
+}
+
+// Validate checks the well against the application profile, DefaultApplicationProfile when nil, before it is
+// submitted with WellService.Create.  Missing fields are returned as ValidationErrors
+func (model *WellModel) Validate(profile *ApplicationProfile) error {
+	if profile == nil {
+		profile = DefaultApplicationProfile
+	}
+	if err := profile.check(); err != nil {
+		return err
+	}
+
+	value := reflect.ValueOf(model)
+	var errs ValidationErrors
+	for _, field := range profile.RequiredFields {
+		if !fieldSet(value, strings.Split(field, ".")) {
+			errs = append(errs, FieldError{Field: field, Message: "is required"})
+		}
+	}
+	for _, field := range profile.RequiredCertifications {
+		if !fieldSet(value, strings.Split(field, ".")) {
+			errs = append(errs, FieldError{Field: field, Message: "must be certified"})
+		}
+	}
+	for _, condition := range profile.Conditions {
+		if !fieldSet(value, strings.Split(condition.When, ".")) {
+			continue
+		}
+		for _, field := range condition.Require {
+			if !fieldSet(value, strings.Split(field, ".")) {
+				errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("is required when %s is set", condition.When)})
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// check validates the profile's fields against WellModel
+func (profile *ApplicationProfile) check() error {
+	wellType := reflect.TypeOf(WellModel{})
+	fields := append([]string(nil), profile.RequiredFields...)
+	for _, condition := range profile.Conditions {
+		fields = append(fields, condition.Require...)
+	}
+	for _, field := range fields {
+		if !columnExists(wellType, strings.Split(field, ".")) {
+			return fmt.Errorf("unknown application field '%s'", field)
+		}
+	}
+
+	booleans := append([]string(nil), profile.RequiredCertifications...)
+	for _, condition := range profile.Conditions {
+		booleans = append(booleans, condition.When)
+	}
+	for _, field := range booleans {
+		fieldType, ok := jsonFieldType(wellType, field)
+		if !ok || fieldType.Kind() != reflect.Bool {
+			return fmt.Errorf("application field '%s' must be a boolean", field)
+		}
+	}
+	return nil
+}
+
+// fieldSet reports whether the dotted path holds a non-empty value.  Paths through lists are set when any element is
+func fieldSet(value reflect.Value, path []string) bool {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return false
+		}
+		value = value.Elem()
+	}
+	if len(path) == 0 {
+		return !isEmptyValue(value)
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if fieldSet(value.Index(i), path) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		for _, field := range jsonFields(value.Type()) {
+			if field.name == path[0] {
+				fieldValue, ok := fieldByIndex(value, field.index)
+				return ok && fieldSet(fieldValue, path[1:])
+			}
+		}
+	}
+	return false
+}
+
+// isEmptyValue reports whether value is null, false, zero, empty or blank.  Other types with an IsZero method, such as
+// null.Int and time.Time, decide for themselves
+func isEmptyValue(value reflect.Value) bool {
+	if text, ok := value.Interface().(null.String); ok {
+		return !text.Valid || strings.TrimSpace(text.String) == ""
+	}
+	if zeroer, ok := value.Interface().(interface{ IsZero() bool }); ok {
+		return zeroer.IsZero()
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(value.String()) == ""
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	}
+	return value.IsZero()
+}
@@ -0,0 +1,66 @@
+package hydros
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+	"testing"
+)
+
+// completeApplication well meeting DefaultApplicationProfile
+func completeApplication() *WellModel {
+	return &WellModel{
+		Location: &LocationModel{
+			Latitude:  null.FloatFrom(36.2),
+			Longitude: null.FloatFrom(-100.8),
+			County:    null.StringFrom("Ochiltree"),
+		},
+		Owner:                  &ContactModel{Email: null.StringFrom("owner@example.com")},
+		WellUses:               []WellUse{{WellUse: "Domestic"}},
+		CertifiedBeneficial:    true,
+		CertifiedRules:         true,
+		DistrictRulesAgreement: true,
+		AbideRules:             true,
+		CertifiedInfoCorrect:   true,
+	}
+}
+
+func TestWellModel_Validate(t *testing.T) {
+
+	well := completeApplication()
+	assert.Nil(t, well.Validate(nil), "Error should be nil.")
+
+	well.Location.County = null.StringFrom(" ")
+	well.WellUses = nil
+	well.CertifiedRules = false
+	well.TransportedOutOfGCD = true
+	well.LocationTransported = null.StringFrom("Amarillo")
+	err := well.Validate(nil)
+
+	var validationErrors ValidationErrors
+	if assert.True(t, errors.As(err, &validationErrors)) {
+		assert.Equal(t, ValidationErrors{
+			{Field: "location.county", Message: "is required"},
+			{Field: "wellUses", Message: "is required"},
+			{Field: "certifiedRules", Message: "must be certified"},
+			{Field: "transportedOutOfGCDDescription", Message: "is required when transportedOutOfGCD is set"},
+			{Field: "estimatedAnnualTransportedGallons", Message: "is required when transportedOutOfGCD is set"},
+		}, validationErrors)
+	}
+	assert.Contains(t, err.Error(), "invalid well application: location.county is required; wellUses is required")
+}
+
+func TestWellModel_Validate_Profile(t *testing.T) {
+
+	profile := &ApplicationProfile{
+		RequiredFields:         []string{"owner.email", "construction.casingSize"},
+		RequiredCertifications: []string{"certifiedLocation"},
+	}
+	err := completeApplication().Validate(profile)
+	assert.EqualError(t, err, "invalid well application: construction.casingSize is required; certifiedLocation must be certified")
+
+	assert.EqualError(t, completeApplication().Validate(&ApplicationProfile{RequiredFields: []string{"owner.fax"}}),
+		"unknown application field 'owner.fax'")
+	assert.EqualError(t, completeApplication().Validate(&ApplicationProfile{RequiredCertifications: []string{"notes"}}),
+		"application field 'notes' must be a boolean")
+}
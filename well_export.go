@@ -0,0 +1,309 @@
+package hydros
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WellProperty well attribute included in exports
+type WellProperty string
+
+// WellProperty constants
+const (
+	WellPropertySerial      WellProperty = "serial"
+	WellPropertyName        WellProperty = "name"
+	WellPropertyStatus      WellProperty = "status"
+	WellPropertyStateWellID WellProperty = "stateWellId"
+	WellPropertyCounty      WellProperty = "county"
+	WellPropertySystem      WellProperty = "system"
+	WellPropertyWellUses    WellProperty = "wellUses"
+)
+
+// DefaultWellProperties properties exported when none are given
+var DefaultWellProperties = []WellProperty{
+	WellPropertySerial,
+	WellPropertyName,
+	WellPropertyStatus,
+	WellPropertyStateWellID,
+	WellPropertyCounty,
+	WellPropertySystem,
+	WellPropertyWellUses,
+}
+
+// errEncoderClosed returned when writing to a closed encoder
+var errEncoderClosed = errors.New("encoder is closed")
+
+// wellPropertyValue returns the value of property for well, or nil when it is not set
+func wellPropertyValue(well *WellModel, property WellProperty) interface{} {
+	switch property {
+	case WellPropertySerial:
+		if well.Serial != "" {
+			return well.Serial
+		}
+	case WellPropertyName:
+		if well.Name.Valid {
+			return well.Name.String
+		}
+	case WellPropertyStatus:
+		if well.Status != nil {
+			return well.Status.Status
+		}
+	case WellPropertyStateWellID:
+		if well.StateWellID.Valid {
+			return well.StateWellID.String
+		}
+	case WellPropertyCounty:
+		if well.Location != nil && well.Location.County.Valid {
+			return well.Location.County.String
+		}
+	case WellPropertySystem:
+		if well.System != nil && well.System.Name.Valid {
+			return well.System.Name.String
+		}
+	case WellPropertyWellUses:
+		if len(well.WellUses) > 0 {
+			uses := make([]string, len(well.WellUses))
+			for i, use := range well.WellUses {
+				uses[i] = use.WellUse
+			}
+			return uses
+		}
+	}
+	return nil
+}
+
+// exportProperties returns properties, falling back to DefaultWellProperties, or an error for an unknown property
+func exportProperties(properties []WellProperty) ([]WellProperty, error) {
+	if len(properties) == 0 {
+		return DefaultWellProperties, nil
+	}
+	for _, property := range properties {
+		switch property {
+		case WellPropertySerial, WellPropertyName, WellPropertyStatus, WellPropertyStateWellID, WellPropertyCounty,
+			WellPropertySystem, WellPropertyWellUses:
+		default:
+			return nil, fmt.Errorf("unknown well property '%s'", property)
+		}
+	}
+	return properties, nil
+}
+
+// GeoJSONEncoder streams wells to a GeoJSON FeatureCollection.  Close must be called to finish the collection
+type GeoJSONEncoder struct {
+	writer     *bufio.Writer
+	properties []WellProperty
+	count      int
+	closed     bool
+	err        error
+}
+
+// geoJSONFeature GeoJSON feature of a single well
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	ID         uint                   `json:"id"`
+	Geometry   *geoJSONPoint          `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// geoJSONPoint GeoJSON point geometry
+type geoJSONPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// NewGeoJSONEncoder creates an encoder writing the given well properties to w.  No properties selects
+// DefaultWellProperties.  An unknown property is returned as an error by Encode and Close
+func NewGeoJSONEncoder(w io.Writer, properties ...WellProperty) *GeoJSONEncoder {
+	properties, err := exportProperties(properties)
+	return &GeoJSONEncoder{writer: bufio.NewWriter(w), properties: properties, err: err}
+}
+
+// Encode writes wells as features.  Wells without coordinates get a null geometry and nil wells are skipped
+func (encoder *GeoJSONEncoder) Encode(wells ...*WellModel) error {
+	if encoder.closed {
+		return errEncoderClosed
+	}
+	if encoder.err != nil {
+		return encoder.err
+	}
+	for _, well := range wells {
+		if well == nil {
+			continue
+		}
+		feature := geoJSONFeature{Type: "Feature", Properties: make(map[string]interface{}, len(encoder.properties))}
+		if well.DefaultModelBase != nil {
+			feature.ID = well.ID
+		}
+		if point, ok := well.Point(); ok {
+			feature.Geometry = &geoJSONPoint{Type: "Point", Coordinates: [2]float64{point.Longitude, point.Latitude}}
+		}
+		for _, property := range encoder.properties {
+			feature.Properties[string(property)] = wellPropertyValue(well, property)
+		}
+		featureBytes, err := json.Marshal(feature)
+		if err != nil {
+			return err
+		}
+
+		separator := ","
+		if encoder.count == 0 {
+			separator = `{"type":"FeatureCollection","features":[`
+		}
+		if _, err := encoder.writer.WriteString(separator); err != nil {
+			return err
+		}
+		if _, err := encoder.writer.Write(featureBytes); err != nil {
+			return err
+		}
+		encoder.count++
+	}
+	return nil
+}
+
+// Close finishes the collection and flushes buffered output.  It does not close the underlying writer
+func (encoder *GeoJSONEncoder) Close() error {
+	if encoder.closed {
+		return nil
+	}
+	if encoder.err != nil {
+		return encoder.err
+	}
+	encoder.closed = true
+	trailer := "]}"
+	if encoder.count == 0 {
+		trailer = `{"type":"FeatureCollection","features":[]}`
+	}
+	if _, err := encoder.writer.WriteString(trailer); err != nil {
+		return err
+	}
+	return encoder.writer.Flush()
+}
+
+// WriteGeoJSON writes wells to w as a GeoJSON FeatureCollection
+func WriteGeoJSON(w io.Writer, wells []*WellModel, properties ...WellProperty) error {
+	encoder := NewGeoJSONEncoder(w, properties...)
+	if err := encoder.Encode(wells...); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// KMLEncoder streams wells to a KML document of placemarks.  Close must be called to finish the document
+type KMLEncoder struct {
+	writer     *bufio.Writer
+	properties []WellProperty
+	started    bool
+	closed     bool
+	err        error
+}
+
+// NewKMLEncoder creates an encoder writing the given well properties to w as extended data.  No properties selects
+// DefaultWellProperties.  An unknown property is returned as an error by Encode and Close
+func NewKMLEncoder(w io.Writer, properties ...WellProperty) *KMLEncoder {
+	properties, err := exportProperties(properties)
+	return &KMLEncoder{writer: bufio.NewWriter(w), properties: properties, err: err}
+}
+
+// Encode writes wells as placemarks named by serial.  Wells without coordinates are written without a point and nil
+// wells are skipped
+func (encoder *KMLEncoder) Encode(wells ...*WellModel) error {
+	if encoder.closed {
+		return errEncoderClosed
+	}
+	if encoder.err != nil {
+		return encoder.err
+	}
+	if err := encoder.start(); err != nil {
+		return err
+	}
+	for _, well := range wells {
+		if well == nil {
+			continue
+		}
+		var placemark strings.Builder
+		placemark.WriteString("<Placemark")
+		if well.DefaultModelBase != nil {
+			fmt.Fprintf(&placemark, ` id="well-%d"`, well.ID)
+		}
+		placemark.WriteString("><name>")
+		writeXMLText(&placemark, well.Serial)
+		placemark.WriteString("</name><ExtendedData>")
+		for _, property := range encoder.properties {
+			placemark.WriteString(`<Data name="`)
+			writeXMLText(&placemark, string(property))
+			placemark.WriteString(`"><value>`)
+			writeXMLText(&placemark, formatKMLValue(wellPropertyValue(well, property)))
+			placemark.WriteString("</value></Data>")
+		}
+		placemark.WriteString("</ExtendedData>")
+		if point, ok := well.Point(); ok {
+			fmt.Fprintf(&placemark, "<Point><coordinates>%s,%s</coordinates></Point>",
+				strconv.FormatFloat(point.Longitude, 'f', -1, 64), strconv.FormatFloat(point.Latitude, 'f', -1, 64))
+		}
+		placemark.WriteString("</Placemark>\n")
+		if _, err := encoder.writer.WriteString(placemark.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close finishes the document and flushes buffered output.  It does not close the underlying writer
+func (encoder *KMLEncoder) Close() error {
+	if encoder.closed {
+		return nil
+	}
+	if encoder.err != nil {
+		return encoder.err
+	}
+	if err := encoder.start(); err != nil {
+		return err
+	}
+	encoder.closed = true
+	if _, err := encoder.writer.WriteString("</Document>\n</kml>\n"); err != nil {
+		return err
+	}
+	return encoder.writer.Flush()
+}
+
+// start writes the document header once
+func (encoder *KMLEncoder) start() error {
+	if encoder.started {
+		return nil
+	}
+	encoder.started = true
+	_, err := encoder.writer.WriteString(xml.Header + `<kml xmlns="http://www.opengis.net/kml/2.2">` + "\n<Document>\n")
+	return err
+}
+
+// WriteKML writes wells to w as a KML document
+func WriteKML(w io.Writer, wells []*WellModel, properties ...WellProperty) error {
+	encoder := NewKMLEncoder(w, properties...)
+	if err := encoder.Encode(wells...); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// formatKMLValue formats a property value as text
+func formatKMLValue(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case []string:
+		return strings.Join(typed, ", ")
+	default:
+		return fmt.Sprint(typed)
+	}
+}
+
+// writeXMLText writes text escaped for XML character data
+func writeXMLText(builder *strings.Builder, text string) {
+	_ = xml.EscapeText(builder, []byte(text))
+}
@@ -0,0 +1,120 @@
+package hydros
+
+import (
+	"bytes"
+	"encoding/xml"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+	"testing"
+)
+
+func exportWells() []*WellModel {
+	return []*WellModel{
+		{
+			DefaultModelBase: &DefaultModelBase{ID: 1},
+			Serial:           "W-1",
+			Name:             null.StringFrom("Smith & Sons"),
+			Status:           &StatusModel{Status: "Active"},
+			Location: &LocationModel{
+				Latitude:  null.FloatFrom(36.2),
+				Longitude: null.FloatFrom(-100.8),
+				County:    null.StringFrom("Ochiltree"),
+			},
+			System:   &SystemModel{Name: null.StringFrom("North")},
+			WellUses: []WellUse{{WellUse: "Irrigation"}, {WellUse: "Livestock"}},
+		},
+		{DefaultModelBase: &DefaultModelBase{ID: 2}, Serial: "W-2"},
+	}
+}
+
+func TestWriteGeoJSON(t *testing.T) {
+
+	var buf bytes.Buffer
+	err := WriteGeoJSON(&buf, exportWells(), WellPropertySerial, WellPropertyCounty, WellPropertyWellUses)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.JSONEq(t, `{"type":"FeatureCollection","features":[
+		{"type":"Feature","id":1,"geometry":{"type":"Point","coordinates":[-100.8,36.2]},
+		 "properties":{"serial":"W-1","county":"Ochiltree","wellUses":["Irrigation","Livestock"]}},
+		{"type":"Feature","id":2,"geometry":null,"properties":{"serial":"W-2","county":null,"wellUses":null}}]}`,
+		buf.String())
+
+	buf.Reset()
+	assert.Nil(t, WriteGeoJSON(&buf, nil))
+	assert.JSONEq(t, `{"type":"FeatureCollection","features":[]}`, buf.String())
+}
+
+func TestGeoJSONEncoder_Streaming(t *testing.T) {
+
+	var buf bytes.Buffer
+	encoder := NewGeoJSONEncoder(&buf)
+	for _, well := range exportWells() {
+		assert.Nil(t, encoder.Encode(well))
+	}
+	assert.Nil(t, encoder.Close())
+	assert.Equal(t, errEncoderClosed, encoder.Encode(exportWells()[0]))
+	assert.Contains(t, buf.String(), `"status":"Active"`)
+	assert.Contains(t, buf.String(), `"system":"North"`)
+	assert.Contains(t, buf.String(), `"name":"Smith \u0026 Sons"`)
+}
+
+func TestWellExport_NilWells(t *testing.T) {
+
+	wells := append([]*WellModel{nil}, exportWells()...)
+	wells = append(wells, nil)
+
+	var buf bytes.Buffer
+	assert.Nil(t, WriteGeoJSON(&buf, wells, WellPropertySerial))
+	assert.JSONEq(t, `{"type":"FeatureCollection","features":[
+		{"type":"Feature","id":1,"geometry":{"type":"Point","coordinates":[-100.8,36.2]},"properties":{"serial":"W-1"}},
+		{"type":"Feature","id":2,"geometry":null,"properties":{"serial":"W-2"}}]}`,
+		buf.String())
+
+	buf.Reset()
+	assert.Nil(t, WriteKML(&buf, wells, WellPropertySerial))
+	var document struct {
+		Placemarks []struct {
+			ID string `xml:"id,attr"`
+		} `xml:"Document>Placemark"`
+	}
+	assert.Nil(t, xml.Unmarshal(buf.Bytes(), &document), "KML should be well-formed")
+	assert.Len(t, document.Placemarks, 2)
+}
+
+func TestWellExport_UnknownProperty(t *testing.T) {
+
+	var buf bytes.Buffer
+	assert.EqualError(t, WriteGeoJSON(&buf, exportWells(), WellPropertySerial, "sreial"), "unknown well property 'sreial'")
+	assert.EqualError(t, WriteKML(&buf, exportWells(), "sreial"), "unknown well property 'sreial'")
+	assert.EqualError(t, NewGeoJSONEncoder(&buf, "sreial").Close(), "unknown well property 'sreial'")
+	assert.EqualError(t, NewKMLEncoder(&buf, "sreial").Close(), "unknown well property 'sreial'")
+	assert.Equal(t, "", buf.String(), "Nothing should be written")
+}
+
+func TestWriteKML(t *testing.T) {
+
+	var buf bytes.Buffer
+	err := WriteKML(&buf, exportWells(), WellPropertyName, WellPropertyWellUses)
+	assert.Nil(t, err, "Error should be nil.")
+
+	var document struct {
+		Placemarks []struct {
+			ID   string `xml:"id,attr"`
+			Name string `xml:"name"`
+			Data []struct {
+				Name  string `xml:"name,attr"`
+				Value string `xml:"value"`
+			} `xml:"ExtendedData>Data"`
+			Coordinates string `xml:"Point>coordinates"`
+		} `xml:"Document>Placemark"`
+	}
+	assert.Nil(t, xml.Unmarshal(buf.Bytes(), &document), "KML should be well-formed")
+	if assert.Len(t, document.Placemarks, 2) {
+		first := document.Placemarks[0]
+		assert.Equal(t, "well-1", first.ID)
+		assert.Equal(t, "W-1", first.Name)
+		assert.Equal(t, "-100.8,36.2", first.Coordinates)
+		assert.Equal(t, "Smith & Sons", first.Data[0].Value)
+		assert.Equal(t, "Irrigation, Livestock", first.Data[1].Value)
+		assert.Equal(t, "", document.Placemarks[1].Coordinates)
+	}
+}
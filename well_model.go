@@ -1,13 +1,13 @@
 package hydros
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"gopkg.in/guregu/null.v3"
-	"io/ioutil"
 	"net/http"
+	"reflect"
 	"time"
 )
 
@@ -81,11 +81,21 @@ type WellModel struct {
 	CreatedAt                         time.Time               `json:"createdAt,omitempty"`
 	UpdatedAt                         time.Time               `json:"updatedAt,omitempty"`
 
-	_Update        func(model *WellModel, JSONMergePatch []byte) (*WellModel, error)
-	_Save          func(model *WellModel) (*WellModel, error)
-	_Permits       func(model *WellModel) ([]*PermitModel, error)
-	_Delete        func(model *WellModel) error
-	_TriggerUpdate func(model *WellModel) (*WellModel, error)
+	_Update               func(model *WellModel, JSONMergePatch []byte) (*WellModel, error)
+	_UpdateContext        func(model *WellModel, ctx context.Context, JSONMergePatch []byte) (*WellModel, error)
+	_Save                 func(model *WellModel) (*WellModel, error)
+	_SaveContext          func(model *WellModel, ctx context.Context) (*WellModel, error)
+	_Permits              func(model *WellModel) ([]*PermitModel, error)
+	_PermitsContext       func(model *WellModel, ctx context.Context) ([]*PermitModel, error)
+	_Delete               func(model *WellModel) error
+	_DeleteContext        func(model *WellModel, ctx context.Context) error
+	_TriggerUpdate        func(model *WellModel) (*WellModel, error)
+	_TriggerUpdateContext func(model *WellModel, ctx context.Context) (*WellModel, error)
+
+	// snapshot JSON state of the model when it was initialized, used to find changed fields on Save
+	snapshot []byte
+	// etag ETag header of the response the model was read from
+	etag string
 }
 
 // WellSearchResults total and result list of found wells
@@ -97,47 +107,84 @@ type WellSearchResults struct {
 // Init Initializes spec and default backing functions for model instance
 func (model *WellModel) Init(spec *ServiceSpec) *WellModel {
 	model.Spec = spec
+	model.snapshot, _ = json.Marshal(model)
 
 	if serviceMock, ok := spec.ModelServiceCallMocks["Save"]; ok {
 		model._Save = serviceMock.MockFunc.(func(model *WellModel) (*WellModel, error))
 	} else {
 		model._Save = func(model *WellModel) (*WellModel, error) {
-			return nil, errors.New("not implemented")
+			return model._SaveContext(model, context.Background())
 		}
 	}
 
-	if serviceMock, ok := spec.ModelServiceCallMocks["Permits"]; ok {
-		model._Permits = serviceMock.MockFunc.(func(model *WellModel) ([]*PermitModel, error))
+	if serviceMock, ok := spec.ModelServiceCallMocks["SaveContext"]; ok {
+		model._SaveContext = serviceMock.MockFunc.(func(model *WellModel, ctx context.Context) (*WellModel, error))
 	} else {
-		model._Permits = func(model *WellModel) ([]*PermitModel, error) {
-			uri := fmt.Sprintf("%s/%s/%d/permits.json", model.Spec.Client.URL.String(), model.Spec.ServiceName, model.ID)
-			req, err := http.NewRequest("GET", uri, nil)
-			headers := model.Spec.Client.CreateHeadersFunc()
-			for h := 0; h < len(headers); h++ {
-				req.Header.Add(headers[h].Key, headers[h].Value)
+		model._SaveContext = func(model *WellModel, ctx context.Context) (*WellModel, error) {
+			if model.ID == 0 {
+				return model.Spec.Client.Well.CreateContext(ctx, model)
 			}
 
-			resp, err := model.Spec.Client.HTTPClient.Do(req)
+			if model.Spec.Client.saveMode() == SaveFullModel {
+				if err := ValidateLocation(model.Location, model.Spec.Client.DistrictBoundary); err != nil {
+					return nil, err
+				}
+				var well WellModel
+				request := &apiRequest{
+					Method:         http.MethodPut,
+					Path:           fmt.Sprintf("%s/%d.json", model.Spec.ServiceName, model.ID),
+					Body:           model,
+					ExpectedStatus: http.StatusOK,
+					Result:         &well,
+					Headers:        model.Spec.Client.versionHeaders(model),
+				}
+				if err := model.Spec.Client.execute(ctx, request); err != nil {
+					return nil, model.Spec.Client.wellConflict(ctx, model, err)
+				}
+				if well.DefaultModelBase == nil {
+					return nil, ErrEmptyResponse
+				}
+				well.etag = request.ResponseHeader.Get("ETag")
+				return well.Init(model.Spec), nil
+			}
+
+			if model.snapshot == nil {
+				return nil, errors.New("well model must be initialized before it is saved")
+			}
+			current, err := json.Marshal(model)
 			if err != nil {
 				return nil, err
 			}
-
-			bodyBytes, err := ioutil.ReadAll(resp.Body)
+			patch, err := mergePatch(model.snapshot, current, reflect.TypeOf(model))
 			if err != nil {
 				return nil, err
 			}
-
-			if resp.StatusCode != 200 {
-				var errorResponse ErrorResponse
-				err = json.Unmarshal(bodyBytes, &errorResponse)
-				if err == nil && errorResponse.Message != "" {
-					return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-				}
-				return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
+			if patch == nil {
+				return model, nil
 			}
+			return model._UpdateContext(model, ctx, patch)
+		}
+	}
+
+	if serviceMock, ok := spec.ModelServiceCallMocks["Permits"]; ok {
+		model._Permits = serviceMock.MockFunc.(func(model *WellModel) ([]*PermitModel, error))
+	} else {
+		model._Permits = func(model *WellModel) ([]*PermitModel, error) {
+			return model._PermitsContext(model, context.Background())
+		}
+	}
 
+	if serviceMock, ok := spec.ModelServiceCallMocks["PermitsContext"]; ok {
+		model._PermitsContext = serviceMock.MockFunc.(func(model *WellModel, ctx context.Context) ([]*PermitModel, error))
+	} else {
+		model._PermitsContext = func(model *WellModel, ctx context.Context) ([]*PermitModel, error) {
 			var permits []*PermitModel
-			err = json.Unmarshal(bodyBytes, &permits)
+			err := model.Spec.Client.execute(ctx, &apiRequest{
+				Method:         http.MethodGet,
+				Path:           fmt.Sprintf("%s/%d/permits.json", model.Spec.ServiceName, model.ID),
+				ExpectedStatus: http.StatusOK,
+				Result:         &permits,
+			})
 			if err != nil {
 				return nil, err
 			}
@@ -153,37 +200,33 @@ func (model *WellModel) Init(spec *ServiceSpec) *WellModel {
 		model._Update = serviceMock.MockFunc.(func(model *WellModel, JSONMergePatch []byte) (*WellModel, error))
 	} else {
 		model._Update = func(model *WellModel, JSONMergePatch []byte) (*WellModel, error) {
-			uri := fmt.Sprintf("%s/%s/%d.json", model.Spec.Client.URL.String(), model.Spec.ServiceName, model.ID)
-			req, err := http.NewRequest("PATCH", uri, bytes.NewBuffer(JSONMergePatch))
-			headers := model.Spec.Client.CreateHeadersFunc()
-			for h := 0; h < len(headers); h++ {
-				req.Header.Add(headers[h].Key, headers[h].Value)
-			}
+			return model._UpdateContext(model, context.Background(), JSONMergePatch)
+		}
+	}
 
-			resp, err := model.Spec.Client.HTTPClient.Do(req)
-			if err != nil {
+	if serviceMock, ok := spec.ModelServiceCallMocks["UpdateContext"]; ok {
+		model._UpdateContext = serviceMock.MockFunc.(func(model *WellModel, ctx context.Context, JSONMergePatch []byte) (*WellModel, error))
+	} else {
+		model._UpdateContext = func(model *WellModel, ctx context.Context, JSONMergePatch []byte) (*WellModel, error) {
+			if err := model.Spec.Client.validatePatchLocation(model, JSONMergePatch); err != nil {
 				return nil, err
 			}
-
-			bodyBytes, err := ioutil.ReadAll(resp.Body)
-			if err != nil {
-				return nil, err
+			var updatedWell WellModel
+			request := &apiRequest{
+				Method:         http.MethodPatch,
+				Path:           fmt.Sprintf("%s/%d.json", model.Spec.ServiceName, model.ID),
+				Body:           JSONMergePatch,
+				ExpectedStatus: http.StatusAccepted,
+				Result:         &updatedWell,
+				Headers:        model.Spec.Client.versionHeaders(model),
 			}
-
-			if resp.StatusCode != http.StatusAccepted {
-				var errorResponse ErrorResponse
-				err = json.Unmarshal(bodyBytes, &errorResponse)
-				if err == nil && errorResponse.Message != "" {
-					return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-				}
-				return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
+			if err := model.Spec.Client.execute(ctx, request); err != nil {
+				return nil, model.Spec.Client.wellConflict(ctx, model, err)
 			}
-
-			var updatedWell WellModel
-			err = json.Unmarshal(bodyBytes, &updatedWell)
-			if err != nil {
-				return nil, err
+			if updatedWell.DefaultModelBase == nil {
+				return nil, ErrEmptyResponse
 			}
+			updatedWell.etag = request.ResponseHeader.Get("ETag")
 			return updatedWell.Init(model.Spec), nil
 		}
 	}
@@ -192,44 +235,30 @@ func (model *WellModel) Init(spec *ServiceSpec) *WellModel {
 		model._TriggerUpdate = serviceMock.MockFunc.(func(model *WellModel) (*WellModel, error))
 	} else {
 		model._TriggerUpdate = func(model *WellModel) (*WellModel, error) {
+			return model._TriggerUpdateContext(model, context.Background())
+		}
+	}
 
-			jsonStr, err := json.Marshal(model)
-			if err != nil {
-				return nil, err
-			}
-
-			uri := fmt.Sprintf("%s/%s/%d/triggerUpdate.json",
-				model.Spec.Client.URL.String(), model.Spec.ServiceName, model.ID)
-			req, err := http.NewRequest("PUT", uri, bytes.NewBuffer(jsonStr))
-			headers := model.Spec.Client.CreateHeadersFunc()
-			for h := 0; h < len(headers); h++ {
-				req.Header.Add(headers[h].Key, headers[h].Value)
-			}
-
-			resp, err := model.Spec.Client.HTTPClient.Do(req)
-			if err != nil {
-				return nil, err
+	if serviceMock, ok := spec.ModelServiceCallMocks["TriggerUpdateContext"]; ok {
+		model._TriggerUpdateContext = serviceMock.MockFunc.(func(model *WellModel, ctx context.Context) (*WellModel, error))
+	} else {
+		model._TriggerUpdateContext = func(model *WellModel, ctx context.Context) (*WellModel, error) {
+			var well WellModel
+			request := &apiRequest{
+				Method:         http.MethodPut,
+				Path:           fmt.Sprintf("%s/%d/triggerUpdate.json", model.Spec.ServiceName, model.ID),
+				Body:           model,
+				ExpectedStatus: http.StatusOK,
+				Result:         &well,
+				Headers:        model.Spec.Client.versionHeaders(model),
 			}
-
-			bodyBytes, err := ioutil.ReadAll(resp.Body)
-			if err != nil {
-				return nil, err
+			if err := model.Spec.Client.execute(ctx, request); err != nil {
+				return nil, model.Spec.Client.wellConflict(ctx, model, err)
 			}
-
-			if resp.StatusCode != 200 {
-				var errorResponse ErrorResponse
-				err = json.Unmarshal(bodyBytes, &errorResponse)
-				if err == nil && errorResponse.Message != "" {
-					return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-				}
-				return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
-			}
-
-			var well WellModel
-			err = json.Unmarshal(bodyBytes, &well)
-			if err != nil {
-				return nil, err
+			if well.DefaultModelBase == nil {
+				return nil, ErrEmptyResponse
 			}
+			well.etag = request.ResponseHeader.Get("ETag")
 			return well.Init(model.Spec), nil
 		}
 	}
@@ -238,7 +267,18 @@ func (model *WellModel) Init(spec *ServiceSpec) *WellModel {
 		model._Delete = serviceMock.MockFunc.(func(model *WellModel) error)
 	} else {
 		model._Delete = func(model *WellModel) error {
-			return errors.New("not implemented")
+			return model._DeleteContext(model, context.Background())
+		}
+	}
+
+	if serviceMock, ok := spec.ModelServiceCallMocks["DeleteContext"]; ok {
+		model._DeleteContext = serviceMock.MockFunc.(func(model *WellModel, ctx context.Context) error)
+	} else {
+		model._DeleteContext = func(model *WellModel, ctx context.Context) error {
+			return model.Spec.Client.execute(ctx, &apiRequest{
+				Method: http.MethodDelete,
+				Path:   fmt.Sprintf("%s/%d.json", model.Spec.ServiceName, model.ID),
+			})
 		}
 	}
 	return model
@@ -249,26 +289,88 @@ func (model *WellModel) Update(JSONMergePatch []byte) (*WellModel, error) {
 	return model._Update(model, JSONMergePatch)
 }
 
+// UpdateContext update old model with patch data using the provided context
+func (model *WellModel) UpdateContext(ctx context.Context, JSONMergePatch []byte) (*WellModel, error) {
+	return model._UpdateContext(model, ctx, JSONMergePatch)
+}
+
+// UpdateFrom update model with the fields that differ in modified, e.g. an edited Clone.  Returns model unchanged
+// when there are no differences
+func (model *WellModel) UpdateFrom(modified *WellModel) (*WellModel, error) {
+	return model.UpdateFromContext(context.Background(), modified)
+}
+
+// UpdateFromContext update model with the fields that differ in modified using the provided context
+func (model *WellModel) UpdateFromContext(ctx context.Context, modified *WellModel) (*WellModel, error) {
+	patch, err := MergePatch(model, modified)
+	if err != nil {
+		return nil, err
+	}
+	if patch == nil {
+		return model, nil
+	}
+	return model._UpdateContext(model, ctx, patch)
+}
+
+// Clone deep copy of the model, sharing its spec and saved state, for editing without touching the original
+func (model *WellModel) Clone() (*WellModel, error) {
+	encoded, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	var clone WellModel
+	if err := json.Unmarshal(encoded, &clone); err != nil {
+		return nil, err
+	}
+	if model.Spec != nil {
+		clone.Init(model.Spec)
+	}
+	clone.snapshot = model.snapshot
+	clone.etag = model.etag
+	return &clone, nil
+}
+
 // TriggerUpdate update well entry in search DB and take snapshot of well state
 func (model *WellModel) TriggerUpdate() (*WellModel, error) {
 	return model._TriggerUpdate(model)
 }
 
-// Save changed model
+// TriggerUpdateContext update well entry in search DB and take snapshot of well state using the provided context
+func (model *WellModel) TriggerUpdateContext(ctx context.Context) (*WellModel, error) {
+	return model._TriggerUpdateContext(model, ctx)
+}
+
+// Save changed model.  Depending on the client SaveMode only fields changed since the model was fetched or the full
+// model are sent.  Models without an ID are created
 func (model *WellModel) Save() (*WellModel, error) {
 	return model._Save(model)
 }
 
+// SaveContext save changed model using the provided context
+func (model *WellModel) SaveContext(ctx context.Context) (*WellModel, error) {
+	return model._SaveContext(model, ctx)
+}
+
 // Permits fetch permits
 func (model *WellModel) Permits() ([]*PermitModel, error) {
 	return model._Permits(model)
 }
 
+// PermitsContext fetch permits using the provided context
+func (model *WellModel) PermitsContext(ctx context.Context) ([]*PermitModel, error) {
+	return model._PermitsContext(model, ctx)
+}
+
 // Delete model
 func (model *WellModel) Delete() error {
 	return model._Delete(model)
 }
 
+// DeleteContext delete model using the provided context
+func (model *WellModel) DeleteContext(ctx context.Context) error {
+	return model._DeleteContext(model, ctx)
+}
+
 // StatusModel status model for well association
 type StatusModel struct {
 	ID     uint   `json:"id,omitempty"`
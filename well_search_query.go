@@ -0,0 +1,198 @@
+package hydros
+
+import (
+	"fmt"
+	"gopkg.in/guregu/null.v3"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// DefaultPageSize page size used when none is given
+const DefaultPageSize = 25
+
+// searchDateFormat format of dates in search filters
+const searchDateFormat = "2006-01-02"
+
+// WellSearchQuery typed builder for well searches.  Filters are rendered to the "field:value" format of the
+// search.json filters parameter.  Errors are collected and returned by Validate or when the search is sent
+type WellSearchQuery struct {
+	text    string
+	filters []string
+	from    int
+	size    int
+	sorts   []Sort
+	errs    []string
+}
+
+// NewWellSearchQuery creates a query returning the first DefaultPageSize matches
+func NewWellSearchQuery() *WellSearchQuery {
+	return &WellSearchQuery{size: DefaultPageSize}
+}
+
+// Text full-text search terms
+func (query *WellSearchQuery) Text(text string) *WellSearchQuery {
+	query.text = text
+	return query
+}
+
+// Filter adds a raw filter matching field to value.  Prefer the typed helpers where available
+func (query *WellSearchQuery) Filter(field string, value string) *WellSearchQuery {
+	if field == "" {
+		query.errs = append(query.errs, "filter field must not be empty")
+		return query
+	}
+	if strings.Contains(value, ",") {
+		query.errs = append(query.errs, fmt.Sprintf("filter value for '%s' must not contain ','", field))
+		return query
+	}
+	query.filters = append(query.filters, fmt.Sprintf("%s:%s", field, value))
+	return query
+}
+
+// Status only wells with the given status (e.g. "Active")
+func (query *WellSearchQuery) Status(status string) *WellSearchQuery {
+	return query.Filter("status", status)
+}
+
+// County only wells located in county
+func (query *WellSearchQuery) County(county string) *WellSearchQuery {
+	return query.Filter("county", county)
+}
+
+// System only wells belonging to the system with the given ID
+func (query *WellSearchQuery) System(systemID uint) *WellSearchQuery {
+	return query.Filter("systemId", fmt.Sprint(systemID))
+}
+
+// Exempt only exempt or non-exempt wells
+func (query *WellSearchQuery) Exempt(exempt bool) *WellSearchQuery {
+	return query.Filter("exempt", fmt.Sprint(exempt))
+}
+
+// Approved only approved or unapproved wells
+func (query *WellSearchQuery) Approved(approved bool) *WellSearchQuery {
+	return query.Filter("approved", fmt.Sprint(approved))
+}
+
+// WellUse only wells with the given use (e.g. "Irrigation")
+func (query *WellSearchQuery) WellUse(wellUse string) *WellSearchQuery {
+	return query.Filter("wellUses", wellUse)
+}
+
+// ReplacementOf only wells that replaced the well with the given ID
+func (query *WellSearchQuery) ReplacementOf(wellID uint) *WellSearchQuery {
+	return query.Filter("wellReplacementId", fmt.Sprint(wellID))
+}
+
+// DateRange only wells where the date field falls between from and to, inclusive.  A zero from or to leaves that end
+// of the range open
+func (query *WellSearchQuery) DateRange(field string, from time.Time, to time.Time) *WellSearchQuery {
+	fieldType, ok := jsonFieldType(reflect.TypeOf(WellModel{}), field)
+	if !ok || !isDateType(fieldType) {
+		query.errs = append(query.errs, fmt.Sprintf("'%s' is not a date field", field))
+		return query
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		query.errs = append(query.errs, fmt.Sprintf("date range for '%s' ends before it starts", field))
+		return query
+	}
+	return query.Filter(field, fmt.Sprintf("[%s TO %s]", formatRangeDate(from), formatRangeDate(to)))
+}
+
+// DrilledBetween only wells drilled between from and to
+func (query *WellSearchQuery) DrilledBetween(from time.Time, to time.Time) *WellSearchQuery {
+	return query.DateRange("drillingDate", from, to)
+}
+
+// CompletedBetween only wells completed between from and to
+func (query *WellSearchQuery) CompletedBetween(from time.Time, to time.Time) *WellSearchQuery {
+	return query.DateRange("completionDate", from, to)
+}
+
+// ApprovedBetween only wells approved between from and to
+func (query *WellSearchQuery) ApprovedBetween(from time.Time, to time.Time) *WellSearchQuery {
+	return query.DateRange("approvedDate", from, to)
+}
+
+// SortAsc sorts results by field in ascending order.  Calls are applied in order of precedence
+func (query *WellSearchQuery) SortAsc(field string) *WellSearchQuery {
+	return query.sort(field, Asc)
+}
+
+// SortDesc sorts results by field in descending order.  Calls are applied in order of precedence
+func (query *WellSearchQuery) SortDesc(field string) *WellSearchQuery {
+	return query.sort(field, Desc)
+}
+
+// sort appends a sort on a known well field
+func (query *WellSearchQuery) sort(field string, direction SortDirection) *WellSearchQuery {
+	if fieldType, ok := jsonFieldType(reflect.TypeOf(WellModel{}), field); !ok || !isScalarType(fieldType) {
+		query.errs = append(query.errs, fmt.Sprintf("unknown sort field '%s'", field))
+		return query
+	}
+	query.sorts = append(query.sorts, Sort{Field: field, Direction: direction})
+	return query
+}
+
+// From offset of the first result
+func (query *WellSearchQuery) From(from int) *WellSearchQuery {
+	query.from = from
+	return query
+}
+
+// Size maximum number of results, at most MaxPageSize
+func (query *WellSearchQuery) Size(size int) *WellSearchQuery {
+	query.size = size
+	return query
+}
+
+// Page sets From and Size for the zero-based page of the given size
+func (query *WellSearchQuery) Page(page int, size int) *WellSearchQuery {
+	return query.From(page * size).Size(size)
+}
+
+// Validate returns every problem found while building the query
+func (query *WellSearchQuery) Validate() error {
+	errs := append([]string(nil), query.errs...)
+	if query.from < 0 {
+		errs = append(errs, "from parameter must not be negative")
+	}
+	if query.size < 0 {
+		errs = append(errs, "size parameter must not be negative")
+	}
+	if query.size > MaxPageSize {
+		errs = append(errs, fmt.Sprintf("size parameter must not exceed %d", MaxPageSize))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid well search query: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// isDateType reports whether values of fieldType are dates
+func isDateType(fieldType reflect.Type) bool {
+	return fieldType == reflect.TypeOf(time.Time{}) || fieldType.Name() == "Time"
+}
+
+// isScalarType reports whether values of fieldType are single values that can be sorted on
+func isScalarType(fieldType reflect.Type) bool {
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	switch fieldType.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return false
+	case reflect.Struct:
+		return fieldType == reflect.TypeOf(time.Time{}) || fieldType.PkgPath() == reflect.TypeOf(null.String{}).PkgPath()
+	}
+	return true
+}
+
+// formatRangeDate formats one end of a date range, using * for an open end
+func formatRangeDate(date time.Time) string {
+	if date.IsZero() {
+		return "*"
+	}
+	return date.Format(searchDateFormat)
+}
@@ -0,0 +1,69 @@
+package hydros
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWellSearchQuery_Render(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/wells/search.json", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "smith", q.Get("query"))
+		assert.Equal(t, "status:Active,county:Ochiltree,systemId:3,exempt:false,approved:true,wellUses:Irrigation,"+
+			"drillingDate:[2019-01-01 TO 2019-12-31],completionDate:[2020-01-01 TO *]", q.Get("filters"))
+		assert.Equal(t, "serial:asc,location.county:desc", q.Get("sort"))
+		assert.Equal(t, "100", q.Get("from"))
+		assert.Equal(t, "50", q.Get("size"))
+		_, _ = w.Write([]byte(`{"total":1,"results":[{"id":1}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	query := NewWellSearchQuery().
+		Text("smith").
+		Status("Active").
+		County("Ochiltree").
+		System(3).
+		Exempt(false).
+		Approved(true).
+		WellUse("Irrigation").
+		DrilledBetween(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)).
+		CompletedBetween(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}).
+		SortAsc("serial").
+		SortDesc("location.county").
+		Page(2, 50)
+
+	results, err := client.Well.SearchWithQuery(query)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, 1, results.Total)
+	assert.NotNil(t, results.Results[0].Spec, "Returned models should be initialized")
+}
+
+func TestWellSearchQuery_Validate(t *testing.T) {
+
+	assert.Nil(t, NewWellSearchQuery().SortAsc("createdAt").SortDesc("id").Validate())
+
+	err := NewWellSearchQuery().
+		Size(MaxPageSize+1).
+		SortAsc("contacts").
+		SortDesc("nope").
+		County("Lipscomb,Ochiltree").
+		DateRange("serial", time.Time{}, time.Now()).
+		ApprovedBetween(time.Now(), time.Now().Add(-time.Hour)).
+		Validate()
+	assert.EqualError(t, err, "invalid well search query: unknown sort field 'contacts'; unknown sort field 'nope'; "+
+		"filter value for 'county' must not contain ','; 'serial' is not a date field; "+
+		"date range for 'approvedDate' ends before it starts; size parameter must not exceed 150")
+
+	client, err := NewClient()
+	assert.Nil(t, err, "Error should be nil.")
+	_, err = client.Well.SearchWithQuery(NewWellSearchQuery().SortAsc("nope"))
+	assert.EqualError(t, err, "invalid well search query: unknown sort field 'nope'")
+}
@@ -1,14 +1,13 @@
 package hydros
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 // NewWellService creates & initializes new well service
@@ -28,22 +27,62 @@ type WellService interface {
 	Service
 
 	Get(ID uint) (*WellModel, error)
-	GetWellsByIDs(ids []uint) ([]WellModel, error)
+	GetContext(ctx context.Context, ID uint) (*WellModel, error)
+	GetWellsByIDs(ids []uint) ([]*WellModel, error)
+	GetWellsByIDsContext(ctx context.Context, ids []uint) ([]*WellModel, error)
 	Count() (int, error)
+	CountContext(ctx context.Context) (int, error)
 	List(from int, size int, sort []Sort, ids []uint) ([]*WellModel, error)
+	ListContext(ctx context.Context, from int, size int, sort []Sort, ids []uint) ([]*WellModel, error)
 	Search(query string, filters []string, from int, size int, sort []Sort) (*WellSearchResults, error)
+	SearchContext(ctx context.Context, query string, filters []string, from int, size int, sort []Sort) (*WellSearchResults, error)
+	SearchWithQuery(query *WellSearchQuery) (*WellSearchResults, error)
+	SearchWithQueryContext(ctx context.Context, query *WellSearchQuery) (*WellSearchResults, error)
+	SearchAll(query *WellSearchQuery, options ...IteratorOptionFunc) *WellIterator
+	SearchAllContext(ctx context.Context, query *WellSearchQuery, options ...IteratorOptionFunc) *WellIterator
+	ListAll(sort []Sort, ids []uint, options ...IteratorOptionFunc) *WellIterator
+	ListAllContext(ctx context.Context, sort []Sort, ids []uint, options ...IteratorOptionFunc) *WellIterator
+	SearchWithinRadius(center Point, radius Distance, query *WellSearchQuery) ([]*WellDistance, error)
+	SearchWithinRadiusContext(ctx context.Context, center Point, radius Distance, query *WellSearchQuery) ([]*WellDistance, error)
+	SearchWithinBoundingBox(box BoundingBox, query *WellSearchQuery) ([]*WellDistance, error)
+	SearchWithinBoundingBoxContext(ctx context.Context, box BoundingBox, query *WellSearchQuery) ([]*WellDistance, error)
+	SearchWithinPolygon(polygon Polygon, query *WellSearchQuery) ([]*WellDistance, error)
+	SearchWithinPolygonContext(ctx context.Context, polygon Polygon, query *WellSearchQuery) ([]*WellDistance, error)
+	GetReplacementChain(wellID uint) (*ReplacementChain, error)
+	GetReplacementChainContext(ctx context.Context, wellID uint) (*ReplacementChain, error)
 	Create(model *WellModel) (*WellModel, error)
+	CreateContext(ctx context.Context, model *WellModel) (*WellModel, error)
 }
 
 // DefaultWellService default well service struct that contains backing functions
 type DefaultWellService struct {
 	*DefaultService
-	GetFunc           func(ID uint) (*WellModel, error)
-	GetWellsByIDsFunc func(ids []uint) ([]WellModel, error)
-	CountFunc         func() (int, error)
-	ListFunc          func(from int, size int, sort []Sort, ids []uint) ([]*WellModel, error)
-	SearchFunc        func(query string, filters []string, from int, size int, sort []Sort) (*WellSearchResults, error)
-	CreateFunc        func(model *WellModel) (*WellModel, error)
+	GetFunc                            func(ID uint) (*WellModel, error)
+	GetContextFunc                     func(ctx context.Context, ID uint) (*WellModel, error)
+	GetWellsByIDsFunc                  func(ids []uint) ([]*WellModel, error)
+	GetWellsByIDsContextFunc           func(ctx context.Context, ids []uint) ([]*WellModel, error)
+	CountFunc                          func() (int, error)
+	CountContextFunc                   func(ctx context.Context) (int, error)
+	ListFunc                           func(from int, size int, sort []Sort, ids []uint) ([]*WellModel, error)
+	ListContextFunc                    func(ctx context.Context, from int, size int, sort []Sort, ids []uint) ([]*WellModel, error)
+	SearchFunc                         func(query string, filters []string, from int, size int, sort []Sort) (*WellSearchResults, error)
+	SearchContextFunc                  func(ctx context.Context, query string, filters []string, from int, size int, sort []Sort) (*WellSearchResults, error)
+	SearchWithQueryFunc                func(query *WellSearchQuery) (*WellSearchResults, error)
+	SearchWithQueryContextFunc         func(ctx context.Context, query *WellSearchQuery) (*WellSearchResults, error)
+	SearchAllFunc                      func(query *WellSearchQuery, options ...IteratorOptionFunc) *WellIterator
+	SearchAllContextFunc               func(ctx context.Context, query *WellSearchQuery, options ...IteratorOptionFunc) *WellIterator
+	ListAllFunc                        func(sort []Sort, ids []uint, options ...IteratorOptionFunc) *WellIterator
+	ListAllContextFunc                 func(ctx context.Context, sort []Sort, ids []uint, options ...IteratorOptionFunc) *WellIterator
+	SearchWithinRadiusFunc             func(center Point, radius Distance, query *WellSearchQuery) ([]*WellDistance, error)
+	SearchWithinRadiusContextFunc      func(ctx context.Context, center Point, radius Distance, query *WellSearchQuery) ([]*WellDistance, error)
+	SearchWithinBoundingBoxFunc        func(box BoundingBox, query *WellSearchQuery) ([]*WellDistance, error)
+	SearchWithinBoundingBoxContextFunc func(ctx context.Context, box BoundingBox, query *WellSearchQuery) ([]*WellDistance, error)
+	SearchWithinPolygonFunc            func(polygon Polygon, query *WellSearchQuery) ([]*WellDistance, error)
+	SearchWithinPolygonContextFunc     func(ctx context.Context, polygon Polygon, query *WellSearchQuery) ([]*WellDistance, error)
+	GetReplacementChainFunc            func(wellID uint) (*ReplacementChain, error)
+	GetReplacementChainContextFunc     func(ctx context.Context, wellID uint) (*ReplacementChain, error)
+	CreateFunc                         func(model *WellModel) (*WellModel, error)
+	CreateContextFunc                  func(ctx context.Context, model *WellModel) (*WellModel, error)
 }
 
 // Init Initializes spec and default backing functions for service
@@ -53,149 +92,185 @@ func (service *DefaultWellService) Init(spec *ServiceSpec) *DefaultWellService {
 
 	// Define Get backing function
 	service.GetFunc = func(ID uint) (*WellModel, error) {
-		uri := fmt.Sprintf("%s/%s/%d.json", service.Spec.Client.URL.String(), service.Spec.ServiceName, ID)
-		req, err := http.NewRequest("GET", uri, nil)
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
-
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
-
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp.StatusCode != 200 {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
-		}
+		return service.GetContextFunc(context.Background(), ID)
+	}
 
+	// Define GetContext backing function
+	service.GetContextFunc = func(ctx context.Context, ID uint) (*WellModel, error) {
 		var well WellModel
-		err = json.Unmarshal(bodyBytes, &well)
-		if err != nil {
+		request := &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("%s/%d.json", service.Spec.ServiceName, ID),
+			ExpectedStatus: http.StatusOK,
+			Result:         &well,
+		}
+		if err := service.Spec.Client.execute(ctx, request); err != nil {
 			return nil, err
 		}
-
-		initializedWell := well.Init(spec)
-		return initializedWell, nil
+		well.etag = request.ResponseHeader.Get("ETag")
+		return well.Init(spec), nil
 	}
 
-	// Define GetWellsByIDs function
-	service.GetWellsByIDsFunc = func(ids []uint) ([]WellModel, error) {
-		wellIDsStr := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids)), ","), "[]")
-
-		jsonStr := []byte(fmt.Sprintf(`{"ids":[%s]}`, wellIDsStr))
+	// Define GetWellsByIDs backing function
+	service.GetWellsByIDsFunc = func(ids []uint) ([]*WellModel, error) {
+		return service.GetWellsByIDsContextFunc(context.Background(), ids)
+	}
 
-		uri := fmt.Sprintf("%s/%s/wellsByIDs.json", service.Spec.Client.URL.String(), service.Spec.ServiceName)
-		req, err := http.NewRequest("POST", uri, bytes.NewBuffer(jsonStr))
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
+	// Define GetWellsByIDsContext backing function
+	service.GetWellsByIDsContextFunc = func(ctx context.Context, ids []uint) ([]*WellModel, error) {
+		uniqueIDs := uniqueUints(ids)
+		chunkSize, parallelism := service.Spec.Client.wellsByIDsBatching()
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var (
+			mu       sync.Mutex
+			wg       sync.WaitGroup
+			firstErr error
+			found    = make(map[uint]*WellModel, len(uniqueIDs))
+			slots    = make(chan struct{}, parallelism)
+		)
+		for start := 0; start < len(uniqueIDs); start += chunkSize {
+			end := start + chunkSize
+			if end > len(uniqueIDs) {
+				end = len(uniqueIDs)
+			}
 
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+			}
+			if ctx.Err() != nil {
+				break
+			}
 
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
+			wg.Add(1)
+			go func(chunk []uint) {
+				defer wg.Done()
+				defer func() { <-slots }()
+
+				var wells []*WellModel
+				err := service.Spec.Client.execute(ctx, &apiRequest{
+					Method:         http.MethodPost,
+					Path:           fmt.Sprintf("%s/wellsByIDs.json", service.Spec.ServiceName),
+					Body:           wellsByIDsRequest{IDs: chunk},
+					ExpectedStatus: http.StatusOK,
+					Result:         &wells,
+				})
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					return
+				}
+				for _, well := range wells {
+					if well != nil && well.DefaultModelBase != nil {
+						found[well.ID] = well.Init(spec)
+					}
+				}
+			}(uniqueIDs[start:end])
 		}
+		wg.Wait()
 
-		if resp.StatusCode != 200 {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
+		if firstErr != nil {
+			return nil, firstErr
 		}
-
-		var wells []WellModel
-		err = json.Unmarshal(bodyBytes, &wells)
-		if err != nil {
+		if err := ctx.Err(); err != nil {
 			return nil, err
 		}
 
-		initializedWells := make([]WellModel, len(wells))
-		for i := 0; i < len(wells); i++ {
-			initializedWells[i] = *wells[i].Init(spec)
+		wells := make([]*WellModel, len(ids))
+		for i, id := range ids {
+			wells[i] = found[id]
 		}
-		return initializedWells, nil
+		return wells, nil
 	}
 
 	// Define Count backing function
 	service.CountFunc = func() (int, error) {
-		return 0, errors.New("not implemented")
+		return service.CountContextFunc(context.Background())
+	}
+
+	// Define CountContext backing function
+	service.CountContextFunc = func(ctx context.Context) (int, error) {
+		var count countResponse
+		err := service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("%s/count.json", service.Spec.ServiceName),
+			ExpectedStatus: http.StatusOK,
+			Result:         &count,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return count.Count, nil
 	}
 
 	// Define List backing function
 	service.ListFunc = func(from int, size int, sort []Sort, ids []uint) ([]*WellModel, error) {
-		return nil, errors.New("not implemented")
+		return service.ListContextFunc(context.Background(), from, size, sort, ids)
 	}
 
-	// Define Search backing function
-	service.SearchFunc = func(query string, filters []string, from int, size int, sorts []Sort) (*WellSearchResults, error) {
-
-		uri := fmt.Sprintf("%s/%s/search.json", service.Spec.Client.URL.String(), service.Spec.ServiceName)
-		req, err := http.NewRequest("GET", uri, nil)
-		headers := service.Spec.Client.CreateHeadersFunc()
-		for h := 0; h < len(headers); h++ {
-			req.Header.Add(headers[h].Key, headers[h].Value)
-		}
-
-		q := req.URL.Query()
-		if sorts != nil && len(sorts) > 0 {
-			var sortStr []string
-			for _, sort := range sorts {
-				sortStr = append(sortStr, fmt.Sprint(sort.Field, ":", sort.Direction))
-			}
-			q.Add("sort", strings.Join(sortStr, ","))
-		}
-		if filters != nil && len(filters) > 0 {
-			q.Add("filters", strings.Join(filters, ","))
-		}
-		q.Add("from", fmt.Sprint(from))
-		if size > 150 {
-			return nil, errors.New("size parameter must not exceed 150")
+	// Define ListContext backing function
+	service.ListContextFunc = func(ctx context.Context, from int, size int, sort []Sort, ids []uint) ([]*WellModel, error) {
+		q, err := pageQuery(from, size, sort, ids)
+		if err != nil {
+			return nil, err
 		}
-		q.Add("size", fmt.Sprint(size))
-		req.URL.RawQuery = q.Encode()
 
-		resp, err := service.Spec.Client.HTTPClient.Do(req)
+		var wells []*WellModel
+		err = service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("%s.json", service.Spec.ServiceName),
+			Query:          q,
+			ExpectedStatus: http.StatusOK,
+			Result:         &wells,
+		})
 		if err != nil {
 			return nil, err
 		}
 
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
+		for i := 0; i < len(wells); i++ {
+			wells[i] = wells[i].Init(spec)
+		}
+		return wells, nil
+	}
+
+	// Define Search backing function
+	service.SearchFunc = func(query string, filters []string, from int, size int, sort []Sort) (*WellSearchResults, error) {
+		return service.SearchContextFunc(context.Background(), query, filters, from, size, sort)
+	}
+
+	// Define SearchContext backing function
+	service.SearchContextFunc = func(ctx context.Context, query string, filters []string, from int, size int, sorts []Sort) (*WellSearchResults, error) {
+		q, err := pageQuery(from, size, sorts, nil)
 		if err != nil {
 			return nil, err
 		}
-
-		if resp.StatusCode != 200 {
-			var errorResponse ErrorResponse
-			err = json.Unmarshal(bodyBytes, &errorResponse)
-			if err == nil && errorResponse.Message != "" {
-				return nil, fmt.Errorf("%s: %s", errorResponse.Message, errorResponse.Description)
-			}
-			return nil, fmt.Errorf("%d error: %s", resp.StatusCode, string(bodyBytes))
+		if query != "" {
+			q.Add("query", query)
+		}
+		if len(filters) > 0 {
+			q.Add("filters", strings.Join(filters, ","))
 		}
 
 		var wellSearchResults WellSearchResults
-		err = json.Unmarshal(bodyBytes, &wellSearchResults)
+		err = service.Spec.Client.execute(ctx, &apiRequest{
+			Method:         http.MethodGet,
+			Path:           fmt.Sprintf("%s/search.json", service.Spec.ServiceName),
+			Query:          q,
+			ExpectedStatus: http.StatusOK,
+			Result:         &wellSearchResults,
+		})
 		if err != nil {
 			return nil, err
 		}
+
 		initializedWells := make([]*WellModel, len(wellSearchResults.Results))
 		for i := 0; i < len(wellSearchResults.Results); i++ {
 			initializedWells[i] = wellSearchResults.Results[i].Init(spec)
@@ -203,9 +278,132 @@ func (service *DefaultWellService) Init(spec *ServiceSpec) *DefaultWellService {
 		return &WellSearchResults{wellSearchResults.Total, initializedWells}, nil
 	}
 
+	// Define SearchWithQuery backing function
+	service.SearchWithQueryFunc = func(query *WellSearchQuery) (*WellSearchResults, error) {
+		return service.SearchWithQueryContextFunc(context.Background(), query)
+	}
+
+	// Define SearchWithQueryContext backing function
+	service.SearchWithQueryContextFunc = func(ctx context.Context, query *WellSearchQuery) (*WellSearchResults, error) {
+		if query == nil {
+			query = NewWellSearchQuery()
+		}
+		if err := query.Validate(); err != nil {
+			return nil, err
+		}
+		return service.SearchContextFunc(ctx, query.text, query.filters, query.from, query.size, query.sorts)
+	}
+
+	// Define SearchAll backing function
+	service.SearchAllFunc = func(query *WellSearchQuery, options ...IteratorOptionFunc) *WellIterator {
+		return service.SearchAllContextFunc(context.Background(), query, options...)
+	}
+
+	// Define SearchAllContext backing function
+	service.SearchAllContextFunc = func(ctx context.Context, query *WellSearchQuery, options ...IteratorOptionFunc) *WellIterator {
+		if query == nil {
+			query = NewWellSearchQuery()
+		}
+		if err := query.Validate(); err != nil {
+			return &WellIterator{pager: failedPager(err)}
+		}
+		return &WellIterator{pager: newPager(ctx, query.from,
+			func(ctx context.Context, from int, size int) ([]interface{}, int, error) {
+				results, err := service.SearchContextFunc(ctx, query.text, query.filters, from, size, query.sorts)
+				if err != nil {
+					return nil, 0, err
+				}
+				items := make([]interface{}, len(results.Results))
+				for i, well := range results.Results {
+					items[i] = well
+				}
+				return items, results.Total, nil
+			}, options)}
+	}
+
+	// Define ListAll backing function
+	service.ListAllFunc = func(sort []Sort, ids []uint, options ...IteratorOptionFunc) *WellIterator {
+		return service.ListAllContextFunc(context.Background(), sort, ids, options...)
+	}
+
+	// Define ListAllContext backing function
+	service.ListAllContextFunc = func(ctx context.Context, sort []Sort, ids []uint, options ...IteratorOptionFunc) *WellIterator {
+		return &WellIterator{pager: newPager(ctx, 0,
+			func(ctx context.Context, from int, size int) ([]interface{}, int, error) {
+				wells, err := service.ListContextFunc(ctx, from, size, sort, ids)
+				if err != nil {
+					return nil, 0, err
+				}
+				items := make([]interface{}, len(wells))
+				for i, well := range wells {
+					items[i] = well
+				}
+				return items, -1, nil
+			}, options)}
+	}
+
+	// Define SearchWithinRadius backing function
+	service.SearchWithinRadiusFunc = func(center Point, radius Distance, query *WellSearchQuery) ([]*WellDistance, error) {
+		return service.SearchWithinRadiusContextFunc(context.Background(), center, radius, query)
+	}
+
+	// Define SearchWithinRadiusContext backing function
+	service.SearchWithinRadiusContextFunc = service.searchWithinRadius
+
+	// Define SearchWithinBoundingBox backing function
+	service.SearchWithinBoundingBoxFunc = func(box BoundingBox, query *WellSearchQuery) ([]*WellDistance, error) {
+		return service.SearchWithinBoundingBoxContextFunc(context.Background(), box, query)
+	}
+
+	// Define SearchWithinBoundingBoxContext backing function
+	service.SearchWithinBoundingBoxContextFunc = service.searchWithinBoundingBox
+
+	// Define SearchWithinPolygon backing function
+	service.SearchWithinPolygonFunc = func(polygon Polygon, query *WellSearchQuery) ([]*WellDistance, error) {
+		return service.SearchWithinPolygonContextFunc(context.Background(), polygon, query)
+	}
+
+	// Define SearchWithinPolygonContext backing function
+	service.SearchWithinPolygonContextFunc = service.searchWithinPolygon
+
+	// Define GetReplacementChain backing function
+	service.GetReplacementChainFunc = func(wellID uint) (*ReplacementChain, error) {
+		return service.GetReplacementChainContextFunc(context.Background(), wellID)
+	}
+
+	// Define GetReplacementChainContext backing function
+	service.GetReplacementChainContextFunc = service.getReplacementChain
+
 	// Define Create backing function
-	service.CreateFunc = func(*WellModel) (*WellModel, error) {
-		return nil, errors.New("not implemented")
+	service.CreateFunc = func(model *WellModel) (*WellModel, error) {
+		return service.CreateContextFunc(context.Background(), model)
+	}
+
+	// Define CreateContext backing function
+	service.CreateContextFunc = func(ctx context.Context, model *WellModel) (*WellModel, error) {
+		if model == nil {
+			return nil, errors.New("well model must not be nil")
+		}
+		if err := ValidateLocation(model.Location, service.Spec.Client.DistrictBoundary); err != nil {
+			return nil, err
+		}
+
+		var well WellModel
+		request := &apiRequest{
+			Method:         http.MethodPost,
+			Path:           fmt.Sprintf("%s.json", service.Spec.ServiceName),
+			Body:           model,
+			ExpectedStatus: http.StatusCreated,
+			Result:         &well,
+		}
+		if err := service.Spec.Client.execute(ctx, request); err != nil {
+			return nil, err
+		}
+		if well.DefaultModelBase == nil {
+			return nil, ErrEmptyResponse
+		}
+		well.etag = request.ResponseHeader.Get("ETag")
+		return well.Init(spec), nil
 	}
 
 	return service
@@ -216,27 +414,124 @@ func (service *DefaultWellService) Get(ID uint) (*WellModel, error) {
 	return service.GetFunc(ID)
 }
 
-// Get Get wells by ids
-func (service *DefaultWellService) GetWellsByIDs(ids []uint) ([]WellModel, error) {
+// GetContext Get payload object by id using the provided context
+func (service *DefaultWellService) GetContext(ctx context.Context, ID uint) (*WellModel, error) {
+	return service.GetContextFunc(ctx, ID)
+}
+
+// GetWellsByIDs Get wells by ids.  Large ID sets are fetched in parallel chunks, each ID once.  Wells line up with ids
+// by index, nil where an ID was not found, and repeated IDs share the same model.  MissingWellIDs(ids, wells) lists
+// the IDs that were not found
+func (service *DefaultWellService) GetWellsByIDs(ids []uint) ([]*WellModel, error) {
 	return service.GetWellsByIDsFunc(ids)
 }
 
+// GetWellsByIDsContext Get wells by ids using the provided context
+func (service *DefaultWellService) GetWellsByIDsContext(ctx context.Context, ids []uint) ([]*WellModel, error) {
+	return service.GetWellsByIDsContextFunc(ctx, ids)
+}
+
 // List List objects for service
 func (service *DefaultWellService) List(from int, size int, sort []Sort, ids []uint) ([]*WellModel, error) {
 	return service.ListFunc(from, size, sort, ids)
 }
 
+// ListContext List objects for service using the provided context
+func (service *DefaultWellService) ListContext(ctx context.Context, from int, size int, sort []Sort, ids []uint) ([]*WellModel, error) {
+	return service.ListContextFunc(ctx, from, size, sort, ids)
+}
+
 // Search wells
 func (service *DefaultWellService) Search(query string, filters []string, from int, size int, sort []Sort) (*WellSearchResults, error) {
 	return service.SearchFunc(query, filters, from, size, sort)
 }
 
+// SearchContext Search wells using the provided context
+func (service *DefaultWellService) SearchContext(ctx context.Context, query string, filters []string, from int, size int, sort []Sort) (*WellSearchResults, error) {
+	return service.SearchContextFunc(ctx, query, filters, from, size, sort)
+}
+
+// SearchWithQuery Search wells using a query built with NewWellSearchQuery
+func (service *DefaultWellService) SearchWithQuery(query *WellSearchQuery) (*WellSearchResults, error) {
+	return service.SearchWithQueryFunc(query)
+}
+
+// SearchWithQueryContext Search wells using a query built with NewWellSearchQuery and the provided context
+func (service *DefaultWellService) SearchWithQueryContext(ctx context.Context, query *WellSearchQuery) (*WellSearchResults, error) {
+	return service.SearchWithQueryContextFunc(ctx, query)
+}
+
+// SearchAll iterates over every well matching query, starting at its From offset and fetching pages lazily
+func (service *DefaultWellService) SearchAll(query *WellSearchQuery, options ...IteratorOptionFunc) *WellIterator {
+	return service.SearchAllFunc(query, options...)
+}
+
+// SearchAllContext iterates over every well matching query using the provided context
+func (service *DefaultWellService) SearchAllContext(ctx context.Context, query *WellSearchQuery, options ...IteratorOptionFunc) *WellIterator {
+	return service.SearchAllContextFunc(ctx, query, options...)
+}
+
+// ListAll iterates over every well, fetching pages lazily
+func (service *DefaultWellService) ListAll(sort []Sort, ids []uint, options ...IteratorOptionFunc) *WellIterator {
+	return service.ListAllFunc(sort, ids, options...)
+}
+
+// ListAllContext iterates over every well using the provided context
+func (service *DefaultWellService) ListAllContext(ctx context.Context, sort []Sort, ids []uint, options ...IteratorOptionFunc) *WellIterator {
+	return service.ListAllContextFunc(ctx, sort, ids, options...)
+}
+
 // Count Get a total number of objects
 func (service *DefaultWellService) Count() (int, error) {
 	return service.CountFunc()
 }
 
+// CountContext Get a total number of objects using the provided context
+func (service *DefaultWellService) CountContext(ctx context.Context) (int, error) {
+	return service.CountContextFunc(ctx)
+}
+
 // Create Create new
 func (service *DefaultWellService) Create(model *WellModel) (*WellModel, error) {
 	return service.CreateFunc(model)
 }
+
+// CreateContext Create new using the provided context
+func (service *DefaultWellService) CreateContext(ctx context.Context, model *WellModel) (*WellModel, error) {
+	return service.CreateContextFunc(ctx, model)
+}
+
+// wellsByIDsRequest wellsByIDs.json request payload
+type wellsByIDsRequest struct {
+	IDs []uint `json:"ids"`
+}
+
+// MissingWellIDs returns the IDs without a matching well, e.g. to report IDs GetWellsByIDs did not find
+func MissingWellIDs(ids []uint, wells []*WellModel) []uint {
+	found := make(map[uint]bool, len(wells))
+	for _, well := range wells {
+		if well != nil && well.DefaultModelBase != nil {
+			found[well.ID] = true
+		}
+	}
+	var missing []uint
+	for _, id := range uniqueUints(ids) {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// uniqueUints returns values without duplicates, keeping the first occurrence of each
+func uniqueUints(values []uint) []uint {
+	seen := make(map[uint]bool, len(values))
+	unique := make([]uint, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,238 @@
+package hydros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Well status constants used by DefaultStatusStateMachine
+const (
+	WellStatusApplication = "Application"
+	WellStatusApproved    = "Approved"
+	WellStatusDrilled     = "Drilled"
+	WellStatusCompleted   = "Completed"
+	WellStatusPlugged     = "Plugged"
+)
+
+// StatusStateMachine allowed well status transitions and the fields a well needs before entering a status
+type StatusStateMachine struct {
+	// Initial statuses a well without a status may be given
+	Initial []string
+	// Transitions statuses a well may move to, by current status
+	Transitions map[string][]string
+	// RequiredFields dotted JSON fields (e.g. "drillingDate") that must be set for a well to enter a status
+	RequiredFields map[string][]string
+}
+
+// DefaultStatusStateMachine application -> approved -> drilled -> completed workflow.  Approved, drilled and completed
+// wells may be plugged
+var DefaultStatusStateMachine = &StatusStateMachine{
+	Initial: []string{WellStatusApplication},
+	Transitions: map[string][]string{
+		WellStatusApplication: {WellStatusApproved},
+		WellStatusApproved:    {WellStatusDrilled, WellStatusPlugged},
+		WellStatusDrilled:     {WellStatusCompleted, WellStatusPlugged},
+		WellStatusCompleted:   {WellStatusPlugged},
+	},
+	RequiredFields: map[string][]string{
+		WellStatusApproved:  {"approvedDate"},
+		WellStatusDrilled:   {"approvedDate", "drillingDate"},
+		WellStatusCompleted: {"approvedDate", "drillingDate", "completionDate"},
+	},
+}
+
+// SetStatusStateMachine replaces DefaultStatusStateMachine for well status transitions
+func SetStatusStateMachine(machine *StatusStateMachine) ClientOptionFunc {
+	return func(c *Client) error {
+		if machine == nil {
+			return errors.New("status state machine must not be nil")
+		}
+		wellType := reflect.TypeOf(WellModel{})
+		for status, fields := range machine.RequiredFields {
+			for _, field := range fields {
+				if !columnExists(wellType, strings.Split(field, ".")) {
+					return fmt.Errorf("unknown required field '%s' for status '%s'", field, status)
+				}
+			}
+		}
+		c.StatusStateMachine = machine
+		return nil
+	}
+}
+
+// SetStatusIDs sets the IDs of the district's well status records by name.  TransitionStatus references the target
+// status record by its ID so the well is moved to it rather than the current status record being renamed
+func SetStatusIDs(ids map[string]uint) ClientOptionFunc {
+	return func(c *Client) error {
+		for status, id := range ids {
+			if id == 0 {
+				return fmt.Errorf("status '%s' must have an ID", status)
+			}
+		}
+		c.StatusIDs = ids
+		return nil
+	}
+}
+
+// statusStateMachine returns the configured state machine, falling back to the default
+func (client *Client) statusStateMachine() *StatusStateMachine {
+	if client.StatusStateMachine == nil {
+		return DefaultStatusStateMachine
+	}
+	return client.StatusStateMachine
+}
+
+// StatusTransitionError returned when a well may not move to a status
+type StatusTransitionError struct {
+	From string
+	To   string
+	// MissingFields required fields that are not set, empty when the transition itself is not allowed
+	MissingFields []string
+}
+
+// Error describes the rejected transition
+func (e *StatusTransitionError) Error() string {
+	from := e.From
+	if from == "" {
+		from = "none"
+	}
+	if len(e.MissingFields) > 0 {
+		return fmt.Sprintf("cannot change well status from '%s' to '%s': missing %s",
+			from, e.To, strings.Join(e.MissingFields, ", "))
+	}
+	return fmt.Sprintf("cannot change well status from '%s' to '%s': transition not allowed", from, e.To)
+}
+
+// CanTransition reports whether the machine allows moving from one status to another.  An empty from status
+// allows the initial statuses
+func (machine *StatusStateMachine) CanTransition(from string, to string) bool {
+	allowed := machine.Initial
+	if from != "" {
+		allowed = machine.Transitions[from]
+	}
+	for _, status := range allowed {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition checks well may move to status, including the fields the status requires
+func (machine *StatusStateMachine) ValidateTransition(well *WellModel, status string) error {
+	from := well.StatusName()
+	if !machine.CanTransition(from, status) {
+		return &StatusTransitionError{From: from, To: status}
+	}
+	var missing []string
+	value := reflect.ValueOf(well)
+	for _, field := range machine.RequiredFields[status] {
+		if len(columnValues(value, strings.Split(field, "."))) == 0 {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		return &StatusTransitionError{From: from, To: status, MissingFields: missing}
+	}
+	return nil
+}
+
+// StatusName name of the well's current status, empty when it has none
+func (model *WellModel) StatusName() string {
+	if model.Status == nil {
+		return ""
+	}
+	return model.Status.Status
+}
+
+// TransitionStatus moves the well to status after checking the client's StatusStateMachine, saving it along with
+// any other changes such as the dates the status requires.  The status must have an ID set with SetStatusIDs.
+// Returns model unchanged when it already has status
+func (model *WellModel) TransitionStatus(status string) (*WellModel, error) {
+	return model.TransitionStatusContext(context.Background(), status)
+}
+
+// TransitionStatusContext moves the well to status using the provided context
+func (model *WellModel) TransitionStatusContext(ctx context.Context, status string) (*WellModel, error) {
+	if model.StatusName() == status {
+		return model, nil
+	}
+	if err := model.Spec.Client.statusStateMachine().ValidateTransition(model, status); err != nil {
+		return nil, err
+	}
+
+	// The target status record is referenced by ID, a merge patch of the name alone would rename the current record
+	id, ok := model.Spec.Client.StatusIDs[status]
+	if !ok {
+		return nil, fmt.Errorf("no ID set for well status '%s', see SetStatusIDs", status)
+	}
+	previous := model.Status
+	model.Status = &StatusModel{ID: id, Status: status}
+	saved, err := model._SaveContext(model, ctx)
+	if err != nil {
+		model.Status = previous
+		return nil, err
+	}
+	return saved, nil
+}
+
+// HasSecondaryStatus reports whether the well has the secondary status
+func (model *WellModel) HasSecondaryStatus(status string) bool {
+	for _, secondary := range model.SecondaryStatuses {
+		if secondary != nil && secondary.SecondaryStatus == status {
+			return true
+		}
+	}
+	return false
+}
+
+// AddSecondaryStatus adds a secondary status and saves the well.  Returns model unchanged when it already has status
+func (model *WellModel) AddSecondaryStatus(status string) (*WellModel, error) {
+	return model.AddSecondaryStatusContext(context.Background(), status)
+}
+
+// AddSecondaryStatusContext adds a secondary status using the provided context
+func (model *WellModel) AddSecondaryStatusContext(ctx context.Context, status string) (*WellModel, error) {
+	if model.HasSecondaryStatus(status) {
+		return model, nil
+	}
+	statuses := make([]*SecondaryStatusModel, 0, len(model.SecondaryStatuses)+1)
+	statuses = append(statuses, model.SecondaryStatuses...)
+	statuses = append(statuses, &SecondaryStatusModel{SecondaryStatus: status})
+	return model.saveSecondaryStatuses(ctx, statuses)
+}
+
+// RemoveSecondaryStatus removes a secondary status and saves the well.  Returns model unchanged when it does not have
+// status
+func (model *WellModel) RemoveSecondaryStatus(status string) (*WellModel, error) {
+	return model.RemoveSecondaryStatusContext(context.Background(), status)
+}
+
+// RemoveSecondaryStatusContext removes a secondary status using the provided context
+func (model *WellModel) RemoveSecondaryStatusContext(ctx context.Context, status string) (*WellModel, error) {
+	if !model.HasSecondaryStatus(status) {
+		return model, nil
+	}
+	var statuses []*SecondaryStatusModel
+	for _, secondary := range model.SecondaryStatuses {
+		if secondary != nil && secondary.SecondaryStatus != status {
+			statuses = append(statuses, secondary)
+		}
+	}
+	return model.saveSecondaryStatuses(ctx, statuses)
+}
+
+// saveSecondaryStatuses saves the well with statuses, restoring the previous statuses on failure
+func (model *WellModel) saveSecondaryStatuses(ctx context.Context, statuses []*SecondaryStatusModel) (*WellModel, error) {
+	previous := model.SecondaryStatuses
+	model.SecondaryStatuses = statuses
+	saved, err := model._SaveContext(model, ctx)
+	if err != nil {
+		model.SecondaryStatuses = previous
+		return nil, err
+	}
+	return saved, nil
+}
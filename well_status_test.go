@@ -0,0 +1,141 @@
+package hydros
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestStatusStateMachine_ValidateTransition(t *testing.T) {
+
+	machine := DefaultStatusStateMachine
+	assert.True(t, machine.CanTransition("", WellStatusApplication))
+	assert.True(t, machine.CanTransition(WellStatusDrilled, WellStatusPlugged))
+	assert.False(t, machine.CanTransition(WellStatusApplication, WellStatusCompleted))
+	assert.False(t, machine.CanTransition(WellStatusPlugged, WellStatusApproved))
+
+	well := &WellModel{Status: &StatusModel{Status: WellStatusApproved}, ApprovedDate: null.TimeFrom(time.Now())}
+	err := machine.ValidateTransition(well, WellStatusDrilled)
+	var transitionError *StatusTransitionError
+	if assert.True(t, errors.As(err, &transitionError)) {
+		assert.Equal(t, []string{"drillingDate"}, transitionError.MissingFields)
+	}
+	assert.EqualError(t, err, "cannot change well status from 'Approved' to 'Drilled': missing drillingDate")
+
+	well.DrillingDate = null.TimeFrom(time.Now())
+	assert.Nil(t, machine.ValidateTransition(well, WellStatusDrilled), "Error should be nil.")
+	assert.EqualError(t, machine.ValidateTransition(well, WellStatusApplication),
+		"cannot change well status from 'Approved' to 'Application': transition not allowed")
+}
+
+func TestSetStatusStateMachine(t *testing.T) {
+
+	_, err := NewClient(SetStatusStateMachine(&StatusStateMachine{RequiredFields: map[string][]string{"Active": {"drilledOn"}}}))
+	assert.EqualError(t, err, "unknown required field 'drilledOn' for status 'Active'")
+
+	machine := &StatusStateMachine{
+		Transitions:    map[string][]string{"Active": {"Inactive"}},
+		RequiredFields: map[string][]string{"Inactive": {"location.county"}},
+	}
+	client, err := NewClient(SetStatusStateMachine(machine))
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, machine, client.statusStateMachine())
+}
+
+func TestSetStatusIDs(t *testing.T) {
+
+	_, err := NewClient(SetStatusIDs(map[string]uint{WellStatusApproved: 0}))
+	assert.EqualError(t, err, "status 'Approved' must have an ID")
+
+	client, err := NewClient(SetStatusIDs(map[string]uint{WellStatusApproved: 2}))
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, uint(2), client.StatusIDs[WellStatusApproved])
+}
+
+func TestWellModel_TransitionStatus(t *testing.T) {
+
+	var requests int
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"id":7,"status":{"id":2,"status":"Approved"},"secondaryStatuses":[{"id":1,"secondaryStatus":"Metered"}]}`))
+	}))
+	defer server.Close()
+
+	statusIDs := map[string]uint{WellStatusApplication: 1, WellStatusApproved: 2}
+	client, err := NewClient(SetHost(server.URL), SetStatusIDs(statusIDs))
+	assert.Nil(t, err, "Error should be nil.")
+	original := &StatusModel{ID: 1, Status: WellStatusApplication}
+	well := (&WellModel{
+		DefaultModelBase: &DefaultModelBase{ID: 7},
+		Status:           original,
+	}).Init(client.Well._ServiceSpec())
+
+	_, err = well.TransitionStatus(WellStatusApproved)
+	assert.NotNil(t, err, "Error should not be nil.")
+	assert.Equal(t, 0, requests)
+	assert.Equal(t, WellStatusApplication, well.StatusName(), "Status should be unchanged after a rejected transition")
+
+	// The target status record can only be referenced once its ID is known
+	well.ApprovedDate = null.TimeFrom(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+	client.StatusIDs = nil
+	_, err = well.TransitionStatus(WellStatusApproved)
+	assert.EqualError(t, err, "no ID set for well status 'Approved', see SetStatusIDs")
+	assert.Equal(t, 0, requests)
+	assert.Equal(t, original, well.Status)
+
+	client.StatusIDs = statusIDs
+	approved, err := well.TransitionStatus(WellStatusApproved)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, `{"approvedDate":"2020-01-02T00:00:00Z","status":{"id":2,"status":"Approved"}}`, string(body))
+	assert.Equal(t, &StatusModel{ID: 1, Status: WellStatusApplication}, original, "Previous status should not be modified")
+	assert.Equal(t, WellStatusApproved, approved.StatusName())
+
+	same, err := approved.TransitionStatus(WellStatusApproved)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, approved, same)
+	assert.Equal(t, 1, requests)
+}
+
+func TestWellModel_SecondaryStatuses(t *testing.T) {
+
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"id":7}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+	well := (&WellModel{
+		DefaultModelBase:  &DefaultModelBase{ID: 7},
+		SecondaryStatuses: []*SecondaryStatusModel{{ID: 1, SecondaryStatus: "Metered"}},
+	}).Init(client.Well._ServiceSpec())
+	assert.True(t, well.HasSecondaryStatus("Metered"))
+
+	unchanged, err := well.AddSecondaryStatus("Metered")
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, well, unchanged)
+
+	_, err = well.AddSecondaryStatus("Monitored")
+	assert.Nil(t, err, "Error should be nil.")
+	assert.JSONEq(t, `{"secondaryStatuses":[{"id":1,"secondaryStatus":"Metered"},{"secondaryStatus":"Monitored"}]}`, string(body))
+
+	well = (&WellModel{
+		DefaultModelBase:  &DefaultModelBase{ID: 7},
+		SecondaryStatuses: []*SecondaryStatusModel{{ID: 1, SecondaryStatus: "Metered"}},
+	}).Init(client.Well._ServiceSpec())
+	_, err = well.RemoveSecondaryStatus("Metered")
+	assert.Nil(t, err, "Error should be nil.")
+	assert.JSONEq(t, `{"secondaryStatuses":[]}`, string(body))
+}
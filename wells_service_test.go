@@ -1,9 +1,21 @@
 package hydros
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestDefaultWellService_Init(t *testing.T) {
@@ -22,6 +34,12 @@ func TestDefaultWellService_Init(t *testing.T) {
 	assert.Equal(t, reflect.TypeOf(defaultWellService.CountFunc).Kind(), reflect.Func, "CountFunc should be func")
 	assert.NotNil(t, defaultWellService.ListFunc, "ListFunc should not be null")
 	assert.Equal(t, reflect.TypeOf(defaultWellService.ListFunc).Kind(), reflect.Func, "ListFunc should be func")
+	assert.NotNil(t, defaultWellService.SearchAllContextFunc, "SearchAllContextFunc should not be null")
+	assert.NotNil(t, defaultWellService.ListAllContextFunc, "ListAllContextFunc should not be null")
+	assert.NotNil(t, defaultWellService.SearchWithinRadiusContextFunc, "SearchWithinRadiusContextFunc should not be null")
+	assert.NotNil(t, defaultWellService.SearchWithinBoundingBoxContextFunc, "SearchWithinBoundingBoxContextFunc should not be null")
+	assert.NotNil(t, defaultWellService.SearchWithinPolygonContextFunc, "SearchWithinPolygonContextFunc should not be null")
+	assert.NotNil(t, defaultWellService.GetReplacementChainContextFunc, "GetReplacementChainContextFunc should not be null")
 }
 
 func TestDefaultWellServiceCountFunc(t *testing.T) {
@@ -75,10 +93,10 @@ func TestDefaultWellServiceGetWellsByIDsFunc(t *testing.T) {
 			PayloadModelType: reflect.TypeOf([]WellModel{}),
 		})
 
-	defaultWellService.GetWellsByIDsFunc = func(ids []uint) ([]WellModel, error) {
-		list := make([]WellModel, 2)
-		list[0] = WellModel{DefaultModelBase: &DefaultModelBase{ID: 235711}}
-		list[1] = WellModel{DefaultModelBase: &DefaultModelBase{ID: 235712}}
+	defaultWellService.GetWellsByIDsFunc = func(ids []uint) ([]*WellModel, error) {
+		list := make([]*WellModel, 2)
+		list[0] = &WellModel{DefaultModelBase: &DefaultModelBase{ID: 235711}}
+		list[1] = &WellModel{DefaultModelBase: &DefaultModelBase{ID: 235712}}
 		return list, nil
 	}
 	returnedModels, err := defaultWellService.GetWellsByIDs([]uint{235711, 235712})
@@ -104,3 +122,318 @@ func TestDefaultWellServiceListFunc(t *testing.T) {
 	assert.Nil(t, err, "Error should be nil.")
 	assert.Equal(t, uint(235711), returnedModels[0].ID)
 }
+
+func TestDefaultWellServiceGetContextFunc(t *testing.T) {
+
+	defaultWellService := (&DefaultWellService{DefaultService: &DefaultService{}}).
+		Init(&ServiceSpec{
+			ServiceName:      "test",
+			PayloadModelType: reflect.TypeOf(WellModel{}),
+		})
+
+	type ctxKey string
+	defaultWellService.GetContextFunc = func(ctx context.Context, ID uint) (*WellModel, error) {
+		assert.Equal(t, "value", ctx.Value(ctxKey("key")))
+		return &WellModel{DefaultModelBase: &DefaultModelBase{ID: ID}}, nil
+	}
+	returnedModel, err := defaultWellService.GetContext(context.WithValue(context.Background(), ctxKey("key"), "value"), 2718)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, uint(2718), returnedModel.ID)
+
+	// Non-context variant should delegate to context backing function
+	defaultWellService.GetContextFunc = func(ctx context.Context, ID uint) (*WellModel, error) {
+		return &WellModel{DefaultModelBase: &DefaultModelBase{ID: ID + 1}}, nil
+	}
+	returnedModel, err = defaultWellService.Get(2718)
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, uint(2719), returnedModel.ID)
+}
+
+func TestDefaultWellServiceSearchContext_Canceled(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	results, err := client.Well.SearchContext(ctx, "", nil, 0, 10, nil)
+	assert.Nil(t, results, "Results should be nil")
+	assert.NotNil(t, err, "Error should not be nil.")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "Error should wrap context.DeadlineExceeded")
+}
+
+func TestDefaultWellServiceList(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/wells.json", r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("from"))
+		assert.Equal(t, "25", r.URL.Query().Get("size"))
+		assert.Equal(t, "serial:desc", r.URL.Query().Get("sort"))
+		assert.Equal(t, "3,4", r.URL.Query().Get("ids"))
+		_, _ = w.Write([]byte(`[{"id":3},{"id":4}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	wells, err := client.Well.List(10, 25, []Sort{{Field: "serial", Direction: Desc}}, []uint{3, 4})
+	assert.Nil(t, err, "Error should be nil.")
+	if assert.Len(t, wells, 2) {
+		assert.Equal(t, uint(3), wells[0].ID)
+		assert.Equal(t, uint(4), wells[1].ID)
+		assert.NotNil(t, wells[0].Spec, "Returned models should be initialized")
+		assert.NotNil(t, wells[1]._Update, "Returned models should be initialized")
+	}
+}
+
+func TestDefaultWellServiceCount(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/wells/count.json", r.URL.Path)
+		_, _ = w.Write([]byte(`{"count":1618}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	count, err := client.Well.Count()
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, 1618, count)
+}
+
+func TestDefaultWellService_SizeLimit(t *testing.T) {
+
+	client, err := NewClient()
+	assert.Nil(t, err, "Error should be nil.")
+
+	_, err = client.Well.List(0, MaxPageSize+1, nil, nil)
+	assert.EqualError(t, err, "size parameter must not exceed 150")
+	_, err = client.Well.Search("", nil, 0, MaxPageSize+1, nil)
+	assert.EqualError(t, err, "size parameter must not exceed 150")
+	_, err = client.Well.List(-1, 10, nil, nil)
+	assert.NotNil(t, err, "Error should not be nil.")
+}
+
+func TestDefaultWellServiceCreate(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/wells.json", r.URL.Path)
+		var body map[string]interface{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "W-1", body["serial"])
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":12,"serial":"W-1"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	well, err := client.Well.Create(&WellModel{DefaultModelBase: &DefaultModelBase{}, Serial: "W-1"})
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, uint(12), well.ID)
+	assert.NotNil(t, well._Save, "Returned model should be initialized")
+}
+
+func TestWellModel_Save(t *testing.T) {
+
+	var method string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"id":7,"serial":"W-7","name":"Smith 1","location":{"county":"Lipscomb","city":"Booker"}}`))
+			return
+		}
+		method = r.Method
+		body, _ = ioutil.ReadAll(r.Body)
+		if r.Method == http.MethodPatch {
+			w.WriteHeader(http.StatusAccepted)
+		}
+		_, _ = w.Write([]byte(`{"id":7,"serial":"W-7","name":"Smith 2"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	well, err := client.Well.Get(7)
+	assert.Nil(t, err, "Error should be nil.")
+
+	// Unchanged models are not sent
+	saved, err := well.Save()
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, well, saved)
+	assert.Equal(t, "", method)
+
+	well.Name = null.StringFrom("Smith 2")
+	well.Location.City = null.String{}
+	saved, err = well.Save()
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, http.MethodPatch, method)
+	assert.JSONEq(t, `{"name":"Smith 2","location":{"city":null}}`, string(body))
+	assert.Equal(t, "Smith 2", saved.Name.String)
+
+	client.SaveMode = SaveFullModel
+	_, err = well.Save()
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, http.MethodPut, method)
+	assert.Contains(t, string(body), `"serial":"W-7"`)
+}
+
+func TestWellModel_SaveEmptyResponses(t *testing.T) {
+
+	var response string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusAccepted)
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	for _, response = range []string{"", "{}", "null"} {
+		_, err = client.Well.Create(&WellModel{Serial: "W-1"})
+		assert.Equal(t, ErrEmptyResponse, err, "Create with response %q", response)
+
+		// New wells are created through Save
+		_, err = (&WellModel{DefaultModelBase: &DefaultModelBase{}}).Init(client.Well._ServiceSpec()).Save()
+		assert.Equal(t, ErrEmptyResponse, err, "Save of a new well with response %q", response)
+
+		well := (&WellModel{DefaultModelBase: &DefaultModelBase{ID: 7}}).Init(client.Well._ServiceSpec())
+		well.Serial = "W-7"
+		client.SaveMode = SaveChangedFields
+		_, err = well.Save()
+		assert.Equal(t, ErrEmptyResponse, err, "Changed fields save with response %q", response)
+		client.SaveMode = SaveFullModel
+		_, err = well.Save()
+		assert.Equal(t, ErrEmptyResponse, err, "Full save with response %q", response)
+		_, err = well.TriggerUpdate()
+		assert.Equal(t, ErrEmptyResponse, err, "TriggerUpdate with response %q", response)
+	}
+}
+
+func TestWellModel_Delete(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/wells/7.json", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL))
+	assert.Nil(t, err, "Error should be nil.")
+
+	well := (&WellModel{DefaultModelBase: &DefaultModelBase{ID: 7}}).Init(client.Well._ServiceSpec())
+	assert.Nil(t, well.Delete(), "Error should be nil.")
+}
+
+func TestWellModel_SaveMock(t *testing.T) {
+
+	client, err := NewClient(SetHost("https://api.somewhere.com"))
+	assert.Nil(t, err, "Error should be nil.")
+
+	err = MockModelServiceMethod(client.Well, "SaveContext",
+		func(model *WellModel, ctx context.Context) (*WellModel, error) {
+			model.Serial = "mocked"
+			return model, nil
+		})
+	assert.Nil(t, err, "Error should be nil.")
+
+	well := (&WellModel{DefaultModelBase: &DefaultModelBase{ID: 7}}).Init(client.Well._ServiceSpec())
+	saved, err := well.Save()
+	assert.Nil(t, err, "Error should be nil.")
+	assert.Equal(t, "mocked", saved.Serial)
+}
+
+func TestDefaultWellServiceGetWellsByIDs_Chunked(t *testing.T) {
+
+	var mu sync.Mutex
+	var chunks [][]uint
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		mu.Lock()
+		if current > maxInFlight {
+			maxInFlight = current
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+
+		assert.Equal(t, "/wells/wellsByIDs.json", r.URL.Path)
+		var body wellsByIDsRequest
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		mu.Lock()
+		chunks = append(chunks, body.IDs)
+		mu.Unlock()
+
+		// Respond in reverse order and leave out id 5
+		var wells []string
+		for i := len(body.IDs) - 1; i >= 0; i-- {
+			if body.IDs[i] != 5 {
+				wells = append(wells, fmt.Sprintf(`{"id":%d}`, body.IDs[i]))
+			}
+		}
+		_, _ = fmt.Fprintf(w, "[%s]", strings.Join(wells, ","))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL), SetWellsByIDsBatching(2, 2))
+	assert.Nil(t, err, "Error should be nil.")
+
+	ids := []uint{9, 3, 5, 1, 7, 3, 2, 8}
+	wells, err := client.Well.GetWellsByIDs(ids)
+	assert.Nil(t, err, "Error should be nil.")
+
+	// Results line up with the input, nil for the missing id
+	if assert.Len(t, wells, len(ids)) {
+		for i, well := range wells {
+			if ids[i] == 5 {
+				assert.Nil(t, well, "Missing well should be nil")
+				continue
+			}
+			assert.Equal(t, ids[i], well.ID)
+			assert.NotNil(t, well.Spec, "Returned models should be initialized")
+		}
+		assert.Same(t, wells[1], wells[5], "Repeated ids should share a model")
+	}
+	assert.Equal(t, []uint{5}, MissingWellIDs(ids, wells))
+	assert.Len(t, chunks, 4)
+	assert.True(t, maxInFlight <= 2, "At most 2 requests should be in flight")
+}
+
+func TestDefaultWellServiceGetWellsByIDs_Error(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(SetHost(server.URL), SetWellsByIDsBatching(1, 3))
+	assert.Nil(t, err, "Error should be nil.")
+
+	wells, err := client.Well.GetWellsByIDs([]uint{1, 2, 3, 4, 5})
+	assert.Nil(t, wells, "Wells should be nil")
+	var apiError *APIError
+	assert.True(t, errors.As(err, &apiError), "Error should be an APIError")
+
+	_, err = NewClient(SetWellsByIDsBatching(0, 1))
+	assert.NotNil(t, err, "Error should not be nil.")
+}
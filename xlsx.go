@@ -0,0 +1,146 @@
+package hydros
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// xlsxMaxSheetNameLength longest sheet name Excel accepts
+const xlsxMaxSheetNameLength = 31
+
+// xlsxStaticParts package parts written before the sheet.  Cells use inline strings, so no shared strings or styles
+// parts are needed
+var xlsxStaticParts = []struct {
+	name    string
+	content string
+}{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+// xlsxWriter minimal streaming writer for single sheet XLSX workbooks
+type xlsxWriter struct {
+	archive   *zip.Writer
+	sheet     *bufio.Writer
+	sheetName string
+	row       int
+	err       error
+}
+
+// newXLSXWriter creates a workbook writer.  Parts are written lazily on the first row
+func newXLSXWriter(w io.Writer, sheetName string) *xlsxWriter {
+	if sheetName == "" {
+		sheetName = "Sheet1"
+	}
+	sheetName = strings.NewReplacer(`\`, "_", "/", "_", "?", "_", "*", "_", "[", "_", "]", "_", ":", "_").Replace(sheetName)
+	if len([]rune(sheetName)) > xlsxMaxSheetNameLength {
+		sheetName = string([]rune(sheetName)[:xlsxMaxSheetNameLength])
+	}
+	return &xlsxWriter{archive: zip.NewWriter(w), sheetName: sheetName}
+}
+
+// start writes the package parts and opens the sheet
+func (writer *xlsxWriter) start() error {
+	if writer.sheet != nil || writer.err != nil {
+		return writer.err
+	}
+	for _, part := range xlsxStaticParts {
+		if err := writer.writePart(part.name, part.content); err != nil {
+			return err
+		}
+	}
+	workbook := xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>` +
+		`<sheet name="` + xmlEscape(writer.sheetName) + `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	if err := writer.writePart("xl/workbook.xml", workbook); err != nil {
+		return err
+	}
+
+	sheetWriter, err := writer.archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		writer.err = err
+		return err
+	}
+	writer.sheet = bufio.NewWriter(sheetWriter)
+	_, writer.err = writer.sheet.WriteString(xml.Header +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	return writer.err
+}
+
+// writePart writes a complete package part
+func (writer *xlsxWriter) writePart(name string, content string) error {
+	partWriter, err := writer.archive.Create(name)
+	if err == nil {
+		_, err = io.WriteString(partWriter, content)
+	}
+	writer.err = err
+	return err
+}
+
+// writeRow appends a row of inline string cells, leaving blank cells out
+func (writer *xlsxWriter) writeRow(cells []string) error {
+	if err := writer.start(); err != nil {
+		return err
+	}
+	writer.row++
+	rowNumber := strconv.Itoa(writer.row)
+
+	var row strings.Builder
+	row.WriteString(`<row r="` + rowNumber + `">`)
+	for i, cell := range cells {
+		if cell == "" {
+			continue
+		}
+		row.WriteString(`<c r="` + xlsxColumnName(i) + rowNumber + `" t="inlineStr"><is><t xml:space="preserve">`)
+		row.WriteString(xmlEscape(cell))
+		row.WriteString(`</t></is></c>`)
+	}
+	row.WriteString(`</row>`)
+	_, writer.err = writer.sheet.WriteString(row.String())
+	return writer.err
+}
+
+// close finishes the sheet and the archive
+func (writer *xlsxWriter) close() error {
+	if err := writer.start(); err != nil {
+		return err
+	}
+	if _, err := writer.sheet.WriteString(`</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	if err := writer.sheet.Flush(); err != nil {
+		return err
+	}
+	return writer.archive.Close()
+}
+
+// xlsxColumnName converts a zero-based column index to its letters, e.g. 27 to "AB"
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+// xmlEscape escapes text for XML character data and attributes
+func xmlEscape(text string) string {
+	var escaped strings.Builder
+	_ = xml.EscapeText(&escaped, []byte(text))
+	return escaped.String()
+}